@@ -163,6 +163,23 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetTransactionRequestMessage
+	CmdGetTransactionResponseMessage
+	CmdGetFeeEstimateRequestMessage
+	CmdGetFeeEstimateResponseMessage
+	CmdNotifyMempoolChangedRequestMessage
+	CmdNotifyMempoolChangedResponseMessage
+	CmdMempoolChangedNotificationMessage
+	CmdStopNotifyingMempoolChangedRequestMessage
+	CmdStopNotifyingMempoolChangedResponseMessage
+	CmdGetSubnetworksRequestMessage
+	CmdGetSubnetworksResponseMessage
+	CmdGetTransactionsByAddressRequestMessage
+	CmdGetTransactionsByAddressResponseMessage
+	CmdValidateTransactionRequestMessage
+	CmdValidateTransactionResponseMessage
+	CmdPrioritizeTransactionRequestMessage
+	CmdPrioritizeTransactionResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -300,6 +317,23 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetTransactionRequestMessage:                               "GetTransactionRequest",
+	CmdGetTransactionResponseMessage:                              "GetTransactionResponse",
+	CmdGetFeeEstimateRequestMessage:                               "GetFeeEstimateRequest",
+	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
+	CmdNotifyMempoolChangedRequestMessage:                         "NotifyMempoolChangedRequest",
+	CmdNotifyMempoolChangedResponseMessage:                        "NotifyMempoolChangedResponse",
+	CmdMempoolChangedNotificationMessage:                          "MempoolChangedNotification",
+	CmdStopNotifyingMempoolChangedRequestMessage:                  "StopNotifyingMempoolChangedRequest",
+	CmdStopNotifyingMempoolChangedResponseMessage:                 "StopNotifyingMempoolChangedResponse",
+	CmdGetSubnetworksRequestMessage:                               "GetSubnetworksRequest",
+	CmdGetSubnetworksResponseMessage:                              "GetSubnetworksResponse",
+	CmdGetTransactionsByAddressRequestMessage:                     "GetTransactionsByAddressRequest",
+	CmdGetTransactionsByAddressResponseMessage:                    "GetTransactionsByAddressResponse",
+	CmdValidateTransactionRequestMessage:                          "ValidateTransactionRequest",
+	CmdValidateTransactionResponseMessage:                         "ValidateTransactionResponse",
+	CmdPrioritizeTransactionRequestMessage:                        "PrioritizeTransactionRequest",
+	CmdPrioritizeTransactionResponseMessage:                       "PrioritizeTransactionResponse",
 }
 
 // Message is an interface that describes a kaspa message. A type that
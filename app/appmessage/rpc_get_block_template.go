@@ -4,8 +4,18 @@ package appmessage
 // its respective RPC message
 type GetBlockTemplateRequestMessage struct {
 	baseMessage
-	PayAddress string
-	ExtraData  string
+	PayAddress                  string
+	ExtraData                   string
+	LongPollTemplateID          string
+	LongPollTimeoutMilliseconds uint64
+	Payouts                     []*RPCCoinbasePayout
+}
+
+// RPCCoinbasePayout is an address that receives a share of the block
+// rewards, in proportion to its weight out of the weights of all payouts
+type RPCCoinbasePayout struct {
+	Address string
+	Weight  uint32
 }
 
 // Command returns the protocol command string for the message
@@ -14,10 +24,14 @@ func (msg *GetBlockTemplateRequestMessage) Command() MessageCommand {
 }
 
 // NewGetBlockTemplateRequestMessage returns a instance of the message
-func NewGetBlockTemplateRequestMessage(payAddress, extraData string) *GetBlockTemplateRequestMessage {
+func NewGetBlockTemplateRequestMessage(payAddress, extraData, longPollTemplateID string,
+	longPollTimeoutMilliseconds uint64) *GetBlockTemplateRequestMessage {
+
 	return &GetBlockTemplateRequestMessage{
-		PayAddress: payAddress,
-		ExtraData:  extraData,
+		PayAddress:                  payAddress,
+		ExtraData:                   extraData,
+		LongPollTemplateID:          longPollTemplateID,
+		LongPollTimeoutMilliseconds: longPollTimeoutMilliseconds,
 	}
 }
 
@@ -25,8 +39,9 @@ func NewGetBlockTemplateRequestMessage(payAddress, extraData string) *GetBlockTe
 // its respective RPC message
 type GetBlockTemplateResponseMessage struct {
 	baseMessage
-	Block    *RPCBlock
-	IsSynced bool
+	Block      *RPCBlock
+	IsSynced   bool
+	TemplateID string
 
 	Error *RPCError
 }
@@ -37,9 +52,10 @@ func (msg *GetBlockTemplateResponseMessage) Command() MessageCommand {
 }
 
 // NewGetBlockTemplateResponseMessage returns a instance of the message
-func NewGetBlockTemplateResponseMessage(block *RPCBlock, isSynced bool) *GetBlockTemplateResponseMessage {
+func NewGetBlockTemplateResponseMessage(block *RPCBlock, isSynced bool, templateID string) *GetBlockTemplateResponseMessage {
 	return &GetBlockTemplateResponseMessage{
-		Block:    block,
-		IsSynced: isSynced,
+		Block:      block,
+		IsSynced:   isSynced,
+		TemplateID: templateID,
 	}
 }
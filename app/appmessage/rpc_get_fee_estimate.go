@@ -0,0 +1,52 @@
+package appmessage
+
+// GetFeeEstimateRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFeeEstimateRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFeeEstimateRequestMessage) Command() MessageCommand {
+	return CmdGetFeeEstimateRequestMessage
+}
+
+// NewGetFeeEstimateRequestMessage returns a instance of the message
+func NewGetFeeEstimateRequestMessage() *GetFeeEstimateRequestMessage {
+	return &GetFeeEstimateRequestMessage{}
+}
+
+// RPCFeeEstimateBucket is a fee rate, in sompi per gram of transaction mass,
+// together with the time a transaction that pays it is expected to wait until
+// it's mined
+type RPCFeeEstimateBucket struct {
+	FeeRate          float64
+	EstimatedSeconds float64
+}
+
+// GetFeeEstimateResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFeeEstimateResponseMessage struct {
+	baseMessage
+	PriorityBucket *RPCFeeEstimateBucket
+	NormalBucket   *RPCFeeEstimateBucket
+	LowBucket      *RPCFeeEstimateBucket
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFeeEstimateResponseMessage) Command() MessageCommand {
+	return CmdGetFeeEstimateResponseMessage
+}
+
+// NewGetFeeEstimateResponseMessage returns a instance of the message
+func NewGetFeeEstimateResponseMessage(priorityBucket *RPCFeeEstimateBucket, normalBucket *RPCFeeEstimateBucket,
+	lowBucket *RPCFeeEstimateBucket) *GetFeeEstimateResponseMessage {
+
+	return &GetFeeEstimateResponseMessage{
+		PriorityBucket: priorityBucket,
+		NormalBucket:   normalBucket,
+		LowBucket:      lowBucket,
+	}
+}
@@ -23,7 +23,11 @@ func NewGetSubnetworkRequestMessage(subnetworkID string) *GetSubnetworkRequestMe
 // its respective RPC message
 type GetSubnetworkResponseMessage struct {
 	baseMessage
-	GasLimit uint64
+	GasLimit           uint64
+	FirstSeenBlockHash string
+	IsRegistered       bool
+	GasUsed            uint64
+	TransactionCount   uint64
 
 	Error *RPCError
 }
@@ -34,8 +38,14 @@ func (msg *GetSubnetworkResponseMessage) Command() MessageCommand {
 }
 
 // NewGetSubnetworkResponseMessage returns a instance of the message
-func NewGetSubnetworkResponseMessage(gasLimit uint64) *GetSubnetworkResponseMessage {
+func NewGetSubnetworkResponseMessage(gasLimit uint64, firstSeenBlockHash string, isRegistered bool,
+	gasUsed uint64, transactionCount uint64) *GetSubnetworkResponseMessage {
+
 	return &GetSubnetworkResponseMessage{
-		GasLimit: gasLimit,
+		GasLimit:           gasLimit,
+		FirstSeenBlockHash: firstSeenBlockHash,
+		IsRegistered:       isRegistered,
+		GasUsed:            gasUsed,
+		TransactionCount:   transactionCount,
 	}
 }
@@ -0,0 +1,49 @@
+package appmessage
+
+// GetSubnetworksRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetSubnetworksRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetSubnetworksRequestMessage) Command() MessageCommand {
+	return CmdGetSubnetworksRequestMessage
+}
+
+// NewGetSubnetworksRequestMessage returns a instance of the message
+func NewGetSubnetworksRequestMessage() *GetSubnetworksRequestMessage {
+	return &GetSubnetworksRequestMessage{}
+}
+
+// RPCSubnetwork describes a subnetwork as seen by the virtual selected parent chain.
+// GasLimit is only meaningful when IsRegistered is set
+type RPCSubnetwork struct {
+	SubnetworkID       string
+	FirstSeenBlockHash string
+	IsRegistered       bool
+	GasLimit           uint64
+	GasUsed            uint64
+	TransactionCount   uint64
+}
+
+// GetSubnetworksResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetSubnetworksResponseMessage struct {
+	baseMessage
+	Subnetworks []*RPCSubnetwork
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetSubnetworksResponseMessage) Command() MessageCommand {
+	return CmdGetSubnetworksResponseMessage
+}
+
+// NewGetSubnetworksResponseMessage returns a instance of the message
+func NewGetSubnetworksResponseMessage(subnetworks []*RPCSubnetwork) *GetSubnetworksResponseMessage {
+	return &GetSubnetworksResponseMessage{
+		Subnetworks: subnetworks,
+	}
+}
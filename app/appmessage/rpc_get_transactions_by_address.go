@@ -0,0 +1,81 @@
+package appmessage
+
+// GetTransactionsByAddressRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressRequestMessage struct {
+	baseMessage
+	Address string
+	Limit   uint32
+	Cursor  *TransactionsByAddressEntry
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressRequestMessage
+}
+
+// NewGetTransactionsByAddressRequestMessage returns a instance of the message
+func NewGetTransactionsByAddressRequestMessage(address string, cursor *TransactionsByAddressEntry,
+	limit uint32) *GetTransactionsByAddressRequestMessage {
+
+	return &GetTransactionsByAddressRequestMessage{
+		Address: address,
+		Limit:   limit,
+		Cursor:  cursor,
+	}
+}
+
+// TransactionsByAddressEntry is the sum of the amounts a transaction
+// paid to an address or spent from it
+type TransactionsByAddressEntry struct {
+	TransactionID     string
+	AcceptingDAAScore uint64
+	Direction         TransactionDirection
+	Amount            uint64
+}
+
+// TransactionDirection is whether a transaction paid to an address or spent from it
+type TransactionDirection byte
+
+// TransactionDirection constants
+const (
+	TransactionDirectionReceived TransactionDirection = 0
+	TransactionDirectionSent     TransactionDirection = 1
+)
+
+var transactionDirectionToString = map[TransactionDirection]string{
+	TransactionDirectionReceived: "Received",
+	TransactionDirectionSent:     "Sent",
+}
+
+func (td TransactionDirection) String() string {
+	if directionString, ok := transactionDirectionToString[td]; ok {
+		return directionString
+	}
+	return "Unknown"
+}
+
+// GetTransactionsByAddressResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressResponseMessage struct {
+	baseMessage
+	Entries    []*TransactionsByAddressEntry
+	NextCursor *TransactionsByAddressEntry
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressResponseMessage
+}
+
+// NewGetTransactionsByAddressResponseMessage returns a instance of the message
+func NewGetTransactionsByAddressResponseMessage(entries []*TransactionsByAddressEntry,
+	nextCursor *TransactionsByAddressEntry) *GetTransactionsByAddressResponseMessage {
+
+	return &GetTransactionsByAddressResponseMessage{
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}
+}
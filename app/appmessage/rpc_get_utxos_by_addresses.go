@@ -4,7 +4,10 @@ package appmessage
 // its respective RPC message
 type GetUTXOsByAddressesRequestMessage struct {
 	baseMessage
-	Addresses []string
+	Addresses     []string
+	Limit         uint32
+	MinimumAmount uint64
+	Cursor        *UTXOsByAddressesCursor
 }
 
 // Command returns the protocol command string for the message
@@ -19,11 +22,31 @@ func NewGetUTXOsByAddressesRequestMessage(addresses []string) *GetUTXOsByAddress
 	}
 }
 
+// NewGetUTXOsByAddressesPageRequestMessage returns a instance of the message
+// that requests a single page of UTXOs
+func NewGetUTXOsByAddressesPageRequestMessage(addresses []string, cursor *UTXOsByAddressesCursor,
+	limit uint32, minimumAmount uint64) *GetUTXOsByAddressesRequestMessage {
+
+	return &GetUTXOsByAddressesRequestMessage{
+		Addresses:     addresses,
+		Limit:         limit,
+		MinimumAmount: minimumAmount,
+		Cursor:        cursor,
+	}
+}
+
+// UTXOsByAddressesCursor points at the last entry of a GetUTXOsByAddresses page
+type UTXOsByAddressesCursor struct {
+	Address  string
+	Outpoint *RPCOutpoint
+}
+
 // GetUTXOsByAddressesResponseMessage is an appmessage corresponding to
 // its respective RPC message
 type GetUTXOsByAddressesResponseMessage struct {
 	baseMessage
-	Entries []*UTXOsByAddressesEntry
+	Entries    []*UTXOsByAddressesEntry
+	NextCursor *UTXOsByAddressesCursor
 
 	Error *RPCError
 }
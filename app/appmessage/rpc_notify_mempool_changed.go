@@ -0,0 +1,94 @@
+package appmessage
+
+// NotifyMempoolChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedRequestMessage struct {
+	baseMessage
+	Addresses []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedRequestMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedRequestMessage
+}
+
+// NewNotifyMempoolChangedRequestMessage returns a instance of the message
+func NewNotifyMempoolChangedRequestMessage(addresses []string) *NotifyMempoolChangedRequestMessage {
+	return &NotifyMempoolChangedRequestMessage{
+		Addresses: addresses,
+	}
+}
+
+// NotifyMempoolChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedResponseMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedResponseMessage
+}
+
+// NewNotifyMempoolChangedResponseMessage returns a instance of the message
+func NewNotifyMempoolChangedResponseMessage() *NotifyMempoolChangedResponseMessage {
+	return &NotifyMempoolChangedResponseMessage{}
+}
+
+// MempoolChangedNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type MempoolChangedNotificationMessage struct {
+	baseMessage
+	Added   []*MempoolEntry
+	Removed []*RemovedMempoolEntry
+}
+
+// RemovedMempoolEntry represents a transaction that was removed from the mempool
+type RemovedMempoolEntry struct {
+	TransactionID string
+	IsOrphan      bool
+	Reason        MempoolRemovalReason
+}
+
+// MempoolRemovalReason describes why a transaction was removed from the mempool
+type MempoolRemovalReason byte
+
+// MempoolRemovalReason constants
+const (
+	MempoolRemovalReasonAcceptedInBlock MempoolRemovalReason = 0
+	MempoolRemovalReasonExpired         MempoolRemovalReason = 1
+	MempoolRemovalReasonEvicted         MempoolRemovalReason = 2
+	MempoolRemovalReasonDoubleSpent     MempoolRemovalReason = 3
+	MempoolRemovalReasonOrphanPromoted  MempoolRemovalReason = 4
+	MempoolRemovalReasonInvalid         MempoolRemovalReason = 5
+)
+
+var mempoolRemovalReasonToString = map[MempoolRemovalReason]string{
+	MempoolRemovalReasonAcceptedInBlock: "Accepted in a block",
+	MempoolRemovalReasonExpired:         "Expired",
+	MempoolRemovalReasonEvicted:         "Evicted",
+	MempoolRemovalReasonDoubleSpent:     "Double spent",
+	MempoolRemovalReasonOrphanPromoted:  "Orphan promoted",
+	MempoolRemovalReasonInvalid:         "Invalid",
+}
+
+func (mrr MempoolRemovalReason) String() string {
+	if reasonString, ok := mempoolRemovalReasonToString[mrr]; ok {
+		return reasonString
+	}
+	return "Unknown"
+}
+
+// Command returns the protocol command string for the message
+func (msg *MempoolChangedNotificationMessage) Command() MessageCommand {
+	return CmdMempoolChangedNotificationMessage
+}
+
+// NewMempoolChangedNotificationMessage returns a instance of the message
+func NewMempoolChangedNotificationMessage(added []*MempoolEntry, removed []*RemovedMempoolEntry) *MempoolChangedNotificationMessage {
+	return &MempoolChangedNotificationMessage{
+		Added:   added,
+		Removed: removed,
+	}
+}
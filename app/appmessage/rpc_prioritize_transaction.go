@@ -0,0 +1,46 @@
+package appmessage
+
+// PrioritizeTransactionRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type PrioritizeTransactionRequestMessage struct {
+	baseMessage
+	TransactionID string
+	Address       string
+	FeeDelta      int64
+	Unpin         bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *PrioritizeTransactionRequestMessage) Command() MessageCommand {
+	return CmdPrioritizeTransactionRequestMessage
+}
+
+// NewPrioritizeTransactionRequestMessage returns a instance of the message
+func NewPrioritizeTransactionRequestMessage(transactionID, address string, feeDelta int64,
+	unpin bool) *PrioritizeTransactionRequestMessage {
+
+	return &PrioritizeTransactionRequestMessage{
+		TransactionID: transactionID,
+		Address:       address,
+		FeeDelta:      feeDelta,
+		Unpin:         unpin,
+	}
+}
+
+// PrioritizeTransactionResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type PrioritizeTransactionResponseMessage struct {
+	baseMessage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *PrioritizeTransactionResponseMessage) Command() MessageCommand {
+	return CmdPrioritizeTransactionResponseMessage
+}
+
+// NewPrioritizeTransactionResponseMessage returns a instance of the message
+func NewPrioritizeTransactionResponseMessage() *PrioritizeTransactionResponseMessage {
+	return &PrioritizeTransactionResponseMessage{}
+}
@@ -0,0 +1,37 @@
+package appmessage
+
+// StopNotifyingMempoolChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type StopNotifyingMempoolChangedRequestMessage struct {
+	baseMessage
+	Addresses []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *StopNotifyingMempoolChangedRequestMessage) Command() MessageCommand {
+	return CmdStopNotifyingMempoolChangedRequestMessage
+}
+
+// NewStopNotifyingMempoolChangedRequestMessage returns a instance of the message
+func NewStopNotifyingMempoolChangedRequestMessage(addresses []string) *StopNotifyingMempoolChangedRequestMessage {
+	return &StopNotifyingMempoolChangedRequestMessage{
+		Addresses: addresses,
+	}
+}
+
+// StopNotifyingMempoolChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type StopNotifyingMempoolChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *StopNotifyingMempoolChangedResponseMessage) Command() MessageCommand {
+	return CmdStopNotifyingMempoolChangedResponseMessage
+}
+
+// NewStopNotifyingMempoolChangedResponseMessage returns a instance of the message
+func NewStopNotifyingMempoolChangedResponseMessage() *StopNotifyingMempoolChangedResponseMessage {
+	return &StopNotifyingMempoolChangedResponseMessage{}
+}
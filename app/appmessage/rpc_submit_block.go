@@ -6,6 +6,7 @@ type SubmitBlockRequestMessage struct {
 	baseMessage
 	Block             *RPCBlock
 	AllowNonDAABlocks bool
+	TemplateID        string
 }
 
 // Command returns the protocol command string for the message
@@ -14,10 +15,11 @@ func (msg *SubmitBlockRequestMessage) Command() MessageCommand {
 }
 
 // NewSubmitBlockRequestMessage returns a instance of the message
-func NewSubmitBlockRequestMessage(block *RPCBlock, allowNonDAABlocks bool) *SubmitBlockRequestMessage {
+func NewSubmitBlockRequestMessage(block *RPCBlock, allowNonDAABlocks bool, templateID string) *SubmitBlockRequestMessage {
 	return &SubmitBlockRequestMessage{
 		Block:             block,
 		AllowNonDAABlocks: allowNonDAABlocks,
+		TemplateID:        templateID,
 	}
 }
 
@@ -27,15 +29,17 @@ type RejectReason byte
 // RejectReason constants
 // Not using iota, since in the .proto file those are hardcoded
 const (
-	RejectReasonNone         RejectReason = 0
-	RejectReasonBlockInvalid RejectReason = 1
-	RejectReasonIsInIBD      RejectReason = 2
+	RejectReasonNone          RejectReason = 0
+	RejectReasonBlockInvalid  RejectReason = 1
+	RejectReasonIsInIBD       RejectReason = 2
+	RejectReasonStaleTemplate RejectReason = 3
 )
 
 var rejectReasonToString = map[RejectReason]string{
-	RejectReasonNone:         "None",
-	RejectReasonBlockInvalid: "Block is invalid",
-	RejectReasonIsInIBD:      "Node is in IBD",
+	RejectReasonNone:          "None",
+	RejectReasonBlockInvalid:  "Block is invalid",
+	RejectReasonIsInIBD:       "Node is in IBD",
+	RejectReasonStaleTemplate: "Block template is stale",
 }
 
 func (rr RejectReason) String() string {
@@ -0,0 +1,63 @@
+package appmessage
+
+// ValidateTransactionRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ValidateTransactionRequestMessage struct {
+	baseMessage
+	Transaction *RPCTransaction
+}
+
+// Command returns the protocol command string for the message
+func (msg *ValidateTransactionRequestMessage) Command() MessageCommand {
+	return CmdValidateTransactionRequestMessage
+}
+
+// NewValidateTransactionRequestMessage returns a instance of the message
+func NewValidateTransactionRequestMessage(transaction *RPCTransaction) *ValidateTransactionRequestMessage {
+	return &ValidateTransactionRequestMessage{
+		Transaction: transaction,
+	}
+}
+
+// ValidateTransactionResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ValidateTransactionResponseMessage struct {
+	baseMessage
+	IsAccepted             bool
+	RejectReason           string
+	Mass                   uint64
+	Fee                    uint64
+	FeeRate                float64
+	StandardnessViolations []string
+	InputScriptResults     []*RPCInputScriptResult
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ValidateTransactionResponseMessage) Command() MessageCommand {
+	return CmdValidateTransactionResponseMessage
+}
+
+// NewValidateTransactionResponseMessage returns a instance of the message
+func NewValidateTransactionResponseMessage(isAccepted bool, rejectReason string, mass uint64, fee uint64,
+	feeRate float64, standardnessViolations []string,
+	inputScriptResults []*RPCInputScriptResult) *ValidateTransactionResponseMessage {
+
+	return &ValidateTransactionResponseMessage{
+		IsAccepted:             isAccepted,
+		RejectReason:           rejectReason,
+		Mass:                   mass,
+		Fee:                    fee,
+		FeeRate:                feeRate,
+		StandardnessViolations: standardnessViolations,
+		InputScriptResults:     inputScriptResults,
+	}
+}
+
+// RPCInputScriptResult is the outcome of verifying a transaction input's
+// signature script, meant to be used over RPC
+type RPCInputScriptResult struct {
+	IsValid bool
+	Error   string
+}
@@ -6,12 +6,18 @@ import (
 
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 
+	"github.com/shatll-s/nexelliad/domain/miningmanager/blocktemplatebuilder"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 
 	"github.com/shatll-s/nexelliad/app/protocol"
 	"github.com/shatll-s/nexelliad/app/rpc"
+	"github.com/shatll-s/nexelliad/app/rpc/rpcauth"
 	"github.com/shatll-s/nexelliad/domain"
 	"github.com/shatll-s/nexelliad/domain/consensus"
+	"github.com/shatll-s/nexelliad/domain/historyindex"
+	"github.com/shatll-s/nexelliad/domain/subnetworkindex"
+	"github.com/shatll-s/nexelliad/domain/txindex"
 	"github.com/shatll-s/nexelliad/domain/utxoindex"
 	"github.com/shatll-s/nexelliad/infrastructure/config"
 	infrastructuredatabase "github.com/shatll-s/nexelliad/infrastructure/db/database"
@@ -70,6 +76,7 @@ func (a *ComponentManager) Stop() {
 
 	a.protocolManager.Close()
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
+	close(a.protocolManager.Context().Domain().MempoolEventsChannel())
 
 	return
 }
@@ -92,6 +99,7 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
+	domain.MiningManager().SetTransactionSelectionPolicy(newTransactionSelectionPolicy(cfg.TxSelectionPolicy))
 
 	netAdapter, err := netadapter.NewNetAdapter(cfg)
 	if err != nil {
@@ -113,6 +121,44 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var txIndex *txindex.TXIndex
+	if cfg.TXIndex {
+		txIndex, err = txindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Transaction index started")
+	}
+
+	var subnetworkIndex *subnetworkindex.SubnetworkIndex
+	if cfg.SubnetworkIndex {
+		subnetworkIndex, err = subnetworkindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Subnetwork index started")
+	}
+
+	var historyIndex *historyindex.HistoryIndex
+	if cfg.HistoryIndex {
+		historyIndex, err = historyindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Address history index started")
+	}
+
+	rpcAuthenticator, err := rpcauth.New(cfg.RPCAuthUsers, cfg.RPCAuthTokens)
+	if err != nil {
+		return nil, err
+	}
+	if rpcAuthenticator.IsEnabled() && !cfg.RPCTLS {
+		log.Warnf("RPC authentication is enabled without --rpctls. Credentials will be sent unencrypted")
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -121,7 +167,8 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, txIndex,
+		subnetworkIndex, historyIndex, rpcAuthenticator, domain.ConsensusEventsChannel(), domain.MempoolEventsChannel(), interrupt)
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -142,7 +189,12 @@ func setupRPC(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	subnetworkIndex *subnetworkindex.SubnetworkIndex,
+	historyIndex *historyindex.HistoryIndex,
+	rpcAuthenticator *rpcauth.Authenticator,
 	consensusEventsChan chan externalapi.ConsensusEvent,
+	mempoolEventsChan chan miningmanagermodel.MempoolEvent,
 	shutDownChan chan<- struct{},
 ) *rpc.Manager {
 
@@ -154,7 +206,12 @@ func setupRPC(
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		txIndex,
+		subnetworkIndex,
+		historyIndex,
+		rpcAuthenticator,
 		consensusEventsChan,
+		mempoolEventsChan,
 		shutDownChan,
 	)
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
@@ -172,3 +229,16 @@ func (a *ComponentManager) P2PNodeID() *id.ID {
 func (a *ComponentManager) AddressManager() *addressmanager.AddressManager {
 	return a.addressManager
 }
+
+// newTransactionSelectionPolicy creates the TransactionSelectionPolicy with the given name,
+// which is assumed to have been validated when the config was loaded
+func newTransactionSelectionPolicy(name string) miningmanagermodel.TransactionSelectionPolicy {
+	switch name {
+	case config.TxSelectionPolicyGreedy:
+		return blocktemplatebuilder.NewGreedySelectionPolicy()
+	case config.TxSelectionPolicyPriorityList:
+		return blocktemplatebuilder.NewPriorityListSelectionPolicy()
+	default:
+		return blocktemplatebuilder.NewProbabilisticSelectionPolicy()
+	}
+}
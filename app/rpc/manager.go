@@ -3,21 +3,29 @@ package rpc
 import (
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/app/protocol"
+	"github.com/shatll-s/nexelliad/app/rpc/rpcauth"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
 	"github.com/shatll-s/nexelliad/domain"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+	"github.com/shatll-s/nexelliad/domain/historyindex"
+	"github.com/shatll-s/nexelliad/domain/subnetworkindex"
+	"github.com/shatll-s/nexelliad/domain/txindex"
 	"github.com/shatll-s/nexelliad/domain/utxoindex"
 	"github.com/shatll-s/nexelliad/infrastructure/config"
 	"github.com/shatll-s/nexelliad/infrastructure/logger"
 	"github.com/shatll-s/nexelliad/infrastructure/network/addressmanager"
 	"github.com/shatll-s/nexelliad/infrastructure/network/connmanager"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
 )
 
 // Manager is an RPC manager
 type Manager struct {
-	context *rpccontext.Context
+	context       *rpccontext.Context
+	authenticator *rpcauth.Authenticator
 }
 
 // NewManager creates a new RPC Manager
@@ -29,7 +37,12 @@ func NewManager(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	subnetworkIndex *subnetworkindex.SubnetworkIndex,
+	historyIndex *historyindex.HistoryIndex,
+	authenticator *rpcauth.Authenticator,
 	consensusEventsChan chan externalapi.ConsensusEvent,
+	mempoolEventsChan chan miningmanagermodel.MempoolEvent,
 	shutDownChan chan<- struct{}) *Manager {
 
 	manager := Manager{
@@ -41,12 +54,17 @@ func NewManager(
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			txIndex,
+			subnetworkIndex,
+			historyIndex,
 			shutDownChan,
 		),
+		authenticator: authenticator,
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
 
 	manager.initConsensusEventsHandler(consensusEventsChan)
+	manager.initMempoolEventsHandler(mempoolEventsChan)
 
 	return &manager
 }
@@ -76,6 +94,31 @@ func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalap
 	})
 }
 
+func (m *Manager) initMempoolEventsHandler(mempoolEventsChan chan miningmanagermodel.MempoolEvent) {
+	spawn("mempoolEventsHandler", func() {
+		for {
+			mempoolEvent, ok := <-mempoolEventsChan
+			if !ok {
+				return
+			}
+			switch event := mempoolEvent.(type) {
+			case *miningmanagermodel.TransactionAddedToMempool:
+				err := m.notifyTransactionAddedToMempool(event)
+				if err != nil {
+					panic(err)
+				}
+			case *miningmanagermodel.TransactionRemovedFromMempool:
+				err := m.notifyTransactionRemovedFromMempool(event)
+				if err != nil {
+					panic(err)
+				}
+			default:
+				panic(errors.Errorf("Got event of unsupported type %T", mempoolEvent))
+			}
+		}
+	})
+}
+
 // notifyBlockAddedToDAG notifies the manager that a block has been added to the DAG
 func (m *Manager) notifyBlockAddedToDAG(block *externalapi.DomainBlock) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyBlockAddedToDAG")
@@ -113,6 +156,27 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		}
 	}
 
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Update(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.SubnetworkIndex {
+		err := m.context.SubnetworkIndex.Update(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.HistoryIndex {
+		err := m.context.HistoryIndex.Update(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := m.notifyVirtualSelectedParentBlueScoreChanged(virtualChangeSet.VirtualSelectedParentBlueScore)
 	if err != nil {
 		return err
@@ -151,6 +215,29 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyPruningPointUTXOSetOverride")
 	defer onEnd()
 
+	// The consensus the transaction, subnetwork and address history indexes were built
+	// from has been replaced, so the indexes have to be rebuilt from the new pruning point.
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.SubnetworkIndex {
+		err := m.context.SubnetworkIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.HistoryIndex {
+		err := m.context.HistoryIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
 	if m.context.Config.UTXOIndex {
 		err := m.notifyPruningPointUTXOSetOverride()
 		if err != nil {
@@ -236,3 +323,87 @@ func (m *Manager) notifyVirtualSelectedParentChainChanged(virtualChangeSet *exte
 
 	return nil
 }
+
+// notifyTransactionAddedToMempool notifies the manager that a transaction has been added to the mempool
+func (m *Manager) notifyTransactionAddedToMempool(event *miningmanagermodel.TransactionAddedToMempool) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyTransactionAddedToMempool")
+	defer onEnd()
+
+	// Most nodes do not use this event, so don't bother converting the transaction
+	if !m.context.NotificationManager.HasMempoolChangedListeners() {
+		return nil
+	}
+
+	rpcTransaction := appmessage.DomainTransactionToRPCTransaction(event.Transaction)
+	err := m.context.PopulateTransactionWithVerboseData(rpcTransaction, nil)
+	if err != nil {
+		return err
+	}
+	added := []*appmessage.MempoolEntry{{
+		Fee:         event.Transaction.Fee,
+		Transaction: rpcTransaction,
+		IsOrphan:    event.IsOrphan,
+	}}
+	notification := appmessage.NewMempoolChangedNotificationMessage(added, []*appmessage.RemovedMempoolEntry{})
+	return m.context.NotificationManager.NotifyMempoolChanged(event.Transaction, notification)
+}
+
+// notifyTransactionRemovedFromMempool notifies the manager that a transaction has been removed from the mempool
+func (m *Manager) notifyTransactionRemovedFromMempool(event *miningmanagermodel.TransactionRemovedFromMempool) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyTransactionRemovedFromMempool")
+	defer onEnd()
+
+	if !m.context.NotificationManager.HasMempoolChangedListeners() {
+		return nil
+	}
+
+	removed := []*appmessage.RemovedMempoolEntry{{
+		TransactionID: consensushashing.TransactionID(event.Transaction).String(),
+		IsOrphan:      event.IsOrphan,
+		Reason:        mempoolRemovalReasonToRPC[event.Reason],
+	}}
+	notification := appmessage.NewMempoolChangedNotificationMessage([]*appmessage.MempoolEntry{}, removed)
+	return m.context.NotificationManager.NotifyMempoolChanged(event.Transaction, notification)
+}
+
+var mempoolRemovalReasonToRPC = map[miningmanagermodel.TransactionRemovalReason]appmessage.MempoolRemovalReason{
+	miningmanagermodel.RemovalReasonAcceptedInBlock: appmessage.MempoolRemovalReasonAcceptedInBlock,
+	miningmanagermodel.RemovalReasonExpired:         appmessage.MempoolRemovalReasonExpired,
+	miningmanagermodel.RemovalReasonEvicted:         appmessage.MempoolRemovalReasonEvicted,
+	miningmanagermodel.RemovalReasonDoubleSpent:     appmessage.MempoolRemovalReasonDoubleSpent,
+	miningmanagermodel.RemovalReasonOrphanPromoted:  appmessage.MempoolRemovalReasonOrphanPromoted,
+	miningmanagermodel.RemovalReasonInvalid:         appmessage.MempoolRemovalReasonInvalid,
+}
+
+// connectionRole authenticates the given RPC connection and returns its role.
+// The error is kept rather than returned so that every request from an
+// unauthenticated connection gets a proper error response
+func (m *Manager) connectionRole(netConnection *netadapter.NetConnection) (rpcauth.Role, error) {
+	role, err := m.authenticator.Authenticate(netConnection.Metadata())
+	if err != nil {
+		log.Warnf("RPC authentication failed for %s: %s", netConnection, err)
+		return "", err
+	}
+	if m.authenticator.IsEnabled() {
+		log.Debugf("RPC connection %s authenticated with role %s", netConnection, role)
+	}
+	return role, nil
+}
+
+// authorizationErrorResponse returns an error response for the given request if
+// a connection with the given role and authentication error may not make it,
+// or nil if it may
+func (m *Manager) authorizationErrorResponse(role rpcauth.Role, authenticationErr error,
+	request appmessage.Message) (appmessage.Message, error) {
+
+	var rpcError *appmessage.RPCError
+	switch {
+	case authenticationErr != nil:
+		rpcError = appmessage.RPCErrorf("RPC authentication failed: %s", authenticationErr)
+	case !role.IsAllowed(request.Command()):
+		rpcError = appmessage.RPCErrorf("RPC role %s is not allowed to call %s", role, request.Command())
+	default:
+		return nil, nil
+	}
+	return protowire.RPCErrorResponse(request, rpcError)
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpcauth"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
 	"github.com/shatll-s/nexelliad/app/rpc/rpchandlers"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter"
@@ -51,6 +52,14 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetTransactionRequestMessage:                              rpchandlers.HandleGetTransaction,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
+	appmessage.CmdNotifyMempoolChangedRequestMessage:                        rpchandlers.HandleNotifyMempoolChanged,
+	appmessage.CmdStopNotifyingMempoolChangedRequestMessage:                 rpchandlers.HandleStopNotifyingMempoolChanged,
+	appmessage.CmdGetSubnetworksRequestMessage:                              rpchandlers.HandleGetSubnetworks,
+	appmessage.CmdGetTransactionsByAddressRequestMessage:                    rpchandlers.HandleGetTransactionsByAddress,
+	appmessage.CmdValidateTransactionRequestMessage:                         rpchandlers.HandleValidateTransaction,
+	appmessage.CmdPrioritizeTransactionRequestMessage:                       rpchandlers.HandlePrioritizeTransaction,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -63,22 +72,36 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 		panic(err)
 	}
 	m.context.NotificationManager.AddListener(router)
+	role, authenticationErr := m.connectionRole(netConnection)
 
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, role, authenticationErr)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route,
+	role rpcauth.Role, authenticationErr error) error {
+
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
 		if err != nil {
 			return err
 		}
+		errorResponse, err := m.authorizationErrorResponse(role, authenticationErr, request)
+		if err != nil {
+			return err
+		}
+		if errorResponse != nil {
+			err = outgoingRoute.Enqueue(errorResponse)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		handler, ok := handlers[request.Command()]
 		if !ok {
 			return err
@@ -87,6 +110,10 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if err != nil {
 			return err
 		}
+		// A nil response means that the handler responds asynchronously
+		if response == nil {
+			continue
+		}
 		err = outgoingRoute.Enqueue(response)
 		if err != nil {
 			return err
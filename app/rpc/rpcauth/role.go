@@ -0,0 +1,71 @@
+package rpcauth
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// Role defines the set of RPC commands that a set of credentials is allowed to call
+type Role string
+
+const (
+	// RoleAdmin is allowed to call every RPC command
+	RoleAdmin Role = "admin"
+
+	// RoleUser is allowed to call every RPC command except for the ones
+	// that control the node itself, such as ShutDown, Ban and AddPeer
+	RoleUser Role = "user"
+
+	// RoleReadOnly is allowed to query the node and register for notifications,
+	// but not to submit blocks or transactions or to control the node
+	RoleReadOnly Role = "readonly"
+)
+
+var roles = map[Role]struct{}{
+	RoleAdmin:    {},
+	RoleUser:     {},
+	RoleReadOnly: {},
+}
+
+// adminCommands are the commands that are allowed only for RoleAdmin
+var adminCommands = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdAddPeerRequestMessage:                 {},
+	appmessage.CmdBanRequestMessage:                     {},
+	appmessage.CmdUnbanRequestMessage:                   {},
+	appmessage.CmdShutDownRequestMessage:                {},
+	appmessage.CmdResolveFinalityConflictRequestMessage: {},
+	appmessage.CmdPrioritizeTransactionRequestMessage:   {},
+}
+
+// submitCommands are the commands that change the state of the DAG or the
+// mempool, and are not allowed for RoleReadOnly
+var submitCommands = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdSubmitBlockRequestMessage:       {},
+	appmessage.CmdSubmitTransactionRequestMessage: {},
+}
+
+// IsAllowed returns whether the role is allowed to call the given command
+func (role Role) IsAllowed(command appmessage.MessageCommand) bool {
+	switch role {
+	case RoleAdmin:
+		return true
+	case RoleUser:
+		_, isAdminCommand := adminCommands[command]
+		return !isAdminCommand
+	case RoleReadOnly:
+		_, isAdminCommand := adminCommands[command]
+		_, isSubmitCommand := submitCommands[command]
+		return !isAdminCommand && !isSubmitCommand
+	default:
+		return false
+	}
+}
+
+func parseRole(roleString string) (Role, error) {
+	role := Role(roleString)
+	if _, ok := roles[role]; !ok {
+		return "", errors.Errorf("unknown RPC role %s. Valid roles are %s, %s and %s",
+			roleString, RoleAdmin, RoleUser, RoleReadOnly)
+	}
+	return role, nil
+}
@@ -0,0 +1,162 @@
+package rpcauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AuthorizationMetadataKey is the gRPC metadata key in which RPC clients pass
+// their credentials, in the form of an HTTP Authorization header value:
+// "Bearer <token>" or "Basic <base64 of user:password>"
+const AuthorizationMetadataKey = "authorization"
+
+const (
+	bearerScheme = "Bearer"
+	basicScheme  = "Basic"
+)
+
+type credentialsHash [sha256.Size]byte
+
+type userCredentials struct {
+	passwordHash credentialsHash
+	role         Role
+}
+
+type tokenCredentials struct {
+	tokenHash credentialsHash
+	role      Role
+}
+
+// Authenticator authenticates RPC connections by the credentials in
+// their metadata and resolves the Role they were granted
+type Authenticator struct {
+	users  map[string]*userCredentials
+	tokens []*tokenCredentials
+}
+
+// New creates a new Authenticator from the given user entries, in the form
+// <role>:<user>:<password>, and token entries, in the form <role>:<token>.
+// If no entries are given, authentication is disabled and every connection
+// is granted RoleAdmin.
+func New(userEntries []string, tokenEntries []string) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		users: make(map[string]*userCredentials, len(userEntries)),
+	}
+
+	for _, entry := range userEntries {
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, errors.Errorf("invalid RPC user entry %q: expected <role>:<user>:<password>", redact(entry))
+		}
+		role, err := parseRole(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		user := parts[1]
+		if _, ok := authenticator.users[user]; ok {
+			return nil, errors.Errorf("RPC user %s is defined more than once", user)
+		}
+		authenticator.users[user] = &userCredentials{
+			passwordHash: sha256.Sum256([]byte(parts[2])),
+			role:         role,
+		}
+	}
+
+	for _, entry := range tokenEntries {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, errors.Errorf("invalid RPC token entry %q: expected <role>:<token>", redact(entry))
+		}
+		role, err := parseRole(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		authenticator.tokens = append(authenticator.tokens, &tokenCredentials{
+			tokenHash: sha256.Sum256([]byte(parts[1])),
+			role:      role,
+		})
+	}
+
+	return authenticator, nil
+}
+
+// IsEnabled returns whether any credentials were configured
+func (a *Authenticator) IsEnabled() bool {
+	return len(a.users) > 0 || len(a.tokens) > 0
+}
+
+// Authenticate resolves the Role of a connection from the credentials in its metadata
+func (a *Authenticator) Authenticate(metadata map[string][]string) (Role, error) {
+	if !a.IsEnabled() {
+		return RoleAdmin, nil
+	}
+
+	authorizations := metadata[AuthorizationMetadataKey]
+	if len(authorizations) == 0 {
+		return "", errors.New("no credentials were provided")
+	}
+	if len(authorizations) > 1 {
+		return "", errors.New("more than one set of credentials was provided")
+	}
+
+	scheme, credentials, ok := strings.Cut(authorizations[0], " ")
+	if !ok {
+		return "", errors.New("malformed authorization")
+	}
+	switch {
+	case strings.EqualFold(scheme, bearerScheme):
+		return a.authenticateToken(credentials)
+	case strings.EqualFold(scheme, basicScheme):
+		return a.authenticateUser(credentials)
+	default:
+		return "", errors.Errorf("unsupported authorization scheme %s", scheme)
+	}
+}
+
+func (a *Authenticator) authenticateToken(token string) (Role, error) {
+	tokenHash := sha256.Sum256([]byte(token))
+
+	// Go over all the tokens so that the time this takes does not
+	// depend on which one matched
+	var role Role
+	for _, tokenCredentials := range a.tokens {
+		if subtle.ConstantTimeCompare(tokenHash[:], tokenCredentials.tokenHash[:]) == 1 {
+			role = tokenCredentials.role
+		}
+	}
+	if role == "" {
+		return "", errors.New("invalid token")
+	}
+	return role, nil
+}
+
+func (a *Authenticator) authenticateUser(encodedCredentials string) (Role, error) {
+	decodedCredentials, err := base64.StdEncoding.DecodeString(encodedCredentials)
+	if err != nil {
+		return "", errors.New("malformed basic credentials")
+	}
+	user, password, ok := strings.Cut(string(decodedCredentials), ":")
+	if !ok {
+		return "", errors.New("malformed basic credentials")
+	}
+
+	passwordHash := sha256.Sum256([]byte(password))
+	credentials, ok := a.users[user]
+	if !ok || subtle.ConstantTimeCompare(passwordHash[:], credentials.passwordHash[:]) != 1 {
+		return "", errors.New("invalid user or password")
+	}
+	return credentials.role, nil
+}
+
+// redact keeps the role part of a credentials entry and hides the rest
+func redact(entry string) string {
+	role, _, ok := strings.Cut(entry, ":")
+	if !ok {
+		return "<redacted>"
+	}
+	return role + ":<redacted>"
+}
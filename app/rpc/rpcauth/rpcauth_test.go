@@ -0,0 +1,130 @@
+package rpcauth
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+)
+
+func basicAuthorization(user, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+}
+
+func TestAuthenticate(t *testing.T) {
+	authenticator, err := New(
+		[]string{"admin:alice:pass:with:colons", "readonly:explorer:secret"},
+		[]string{"user:wallet-token"},
+	)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization []string
+		expectedRole  Role
+		expectedError bool
+	}{
+		{name: "admin user", authorization: []string{basicAuthorization("alice", "pass:with:colons")}, expectedRole: RoleAdmin},
+		{name: "readonly user", authorization: []string{basicAuthorization("explorer", "secret")}, expectedRole: RoleReadOnly},
+		{name: "token", authorization: []string{"Bearer wallet-token"}, expectedRole: RoleUser},
+		{name: "lowercase scheme", authorization: []string{"bearer wallet-token"}, expectedRole: RoleUser},
+		{name: "wrong password", authorization: []string{basicAuthorization("explorer", "wrong")}, expectedError: true},
+		{name: "unknown user", authorization: []string{basicAuthorization("mallory", "secret")}, expectedError: true},
+		{name: "wrong token", authorization: []string{"Bearer wrong-token"}, expectedError: true},
+		{name: "no credentials", authorization: nil, expectedError: true},
+		{name: "malformed", authorization: []string{"wallet-token"}, expectedError: true},
+		{name: "unknown scheme", authorization: []string{"Digest wallet-token"}, expectedError: true},
+		{name: "multiple credentials", authorization: []string{"Bearer wallet-token", "Bearer wallet-token"}, expectedError: true},
+	}
+	for _, test := range tests {
+		metadata := map[string][]string{}
+		if test.authorization != nil {
+			metadata[AuthorizationMetadataKey] = test.authorization
+		}
+		role, err := authenticator.Authenticate(metadata)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error but got role %s", test.name, role)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if role != test.expectedRole {
+			t.Errorf("%s: unexpected role. Want: %s, got: %s", test.name, test.expectedRole, role)
+		}
+	}
+}
+
+func TestAuthenticateDisabled(t *testing.T) {
+	authenticator, err := New(nil, nil)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	if authenticator.IsEnabled() {
+		t.Fatalf("Authenticator without credentials is unexpectedly enabled")
+	}
+	role, err := authenticator.Authenticate(nil)
+	if err != nil {
+		t.Fatalf("Authenticate: %+v", err)
+	}
+	if role != RoleAdmin {
+		t.Fatalf("Unexpected role. Want: %s, got: %s", RoleAdmin, role)
+	}
+}
+
+func TestNewInvalidEntries(t *testing.T) {
+	tests := []struct {
+		name         string
+		userEntries  []string
+		tokenEntries []string
+	}{
+		{name: "unknown role", userEntries: []string{"root:alice:pass"}},
+		{name: "missing password", userEntries: []string{"admin:alice"}},
+		{name: "empty password", userEntries: []string{"admin:alice:"}},
+		{name: "duplicate user", userEntries: []string{"admin:alice:pass", "user:alice:other"}},
+		{name: "missing token", tokenEntries: []string{"admin"}},
+		{name: "token with unknown role", tokenEntries: []string{"root:token"}},
+	}
+	for _, test := range tests {
+		_, err := New(test.userEntries, test.tokenEntries)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestRoleIsAllowed(t *testing.T) {
+	tests := []struct {
+		command  appmessage.MessageCommand
+		admin    bool
+		user     bool
+		readOnly bool
+	}{
+		{command: appmessage.CmdGetInfoRequestMessage, admin: true, user: true, readOnly: true},
+		{command: appmessage.CmdNotifyBlockAddedRequestMessage, admin: true, user: true, readOnly: true},
+		{command: appmessage.CmdSubmitTransactionRequestMessage, admin: true, user: true, readOnly: false},
+		{command: appmessage.CmdSubmitBlockRequestMessage, admin: true, user: true, readOnly: false},
+		{command: appmessage.CmdBanRequestMessage, admin: true, user: false, readOnly: false},
+		{command: appmessage.CmdAddPeerRequestMessage, admin: true, user: false, readOnly: false},
+		{command: appmessage.CmdShutDownRequestMessage, admin: true, user: false, readOnly: false},
+	}
+	for _, test := range tests {
+		if RoleAdmin.IsAllowed(test.command) != test.admin {
+			t.Errorf("%s: unexpected IsAllowed for %s", test.command, RoleAdmin)
+		}
+		if RoleUser.IsAllowed(test.command) != test.user {
+			t.Errorf("%s: unexpected IsAllowed for %s", test.command, RoleUser)
+		}
+		if RoleReadOnly.IsAllowed(test.command) != test.readOnly {
+			t.Errorf("%s: unexpected IsAllowed for %s", test.command, RoleReadOnly)
+		}
+	}
+	if Role("unknown").IsAllowed(appmessage.CmdGetInfoRequestMessage) {
+		t.Errorf("Unknown role is unexpectedly allowed to call GetInfo")
+	}
+}
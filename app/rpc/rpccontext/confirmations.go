@@ -0,0 +1,31 @@
+package rpccontext
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// AcceptingBlockConfirmations returns the number of confirmations of a transaction
+// accepted by the given chain block: one when the accepting block is the
+// virtual selected parent, and one more for every blue score unit above it.
+func (ctx *Context) AcceptingBlockConfirmations(acceptingBlockHash *externalapi.DomainHash) (uint64, error) {
+	acceptingBlockInfo, err := ctx.Domain.Consensus().GetBlockInfo(acceptingBlockHash)
+	if err != nil {
+		return 0, err
+	}
+
+	virtualSelectedParent, err := ctx.Domain.Consensus().GetVirtualSelectedParent()
+	if err != nil {
+		return 0, err
+	}
+	virtualSelectedParentInfo, err := ctx.Domain.Consensus().GetBlockInfo(virtualSelectedParent)
+	if err != nil {
+		return 0, err
+	}
+
+	// The transaction index may lag slightly behind consensus, in which case
+	// the accepting block might no longer be below the virtual selected parent
+	if acceptingBlockInfo.BlueScore > virtualSelectedParentInfo.BlueScore {
+		return 0, nil
+	}
+	return virtualSelectedParentInfo.BlueScore - acceptingBlockInfo.BlueScore + 1, nil
+}
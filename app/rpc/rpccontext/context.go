@@ -3,6 +3,9 @@ package rpccontext
 import (
 	"github.com/shatll-s/nexelliad/app/protocol"
 	"github.com/shatll-s/nexelliad/domain"
+	"github.com/shatll-s/nexelliad/domain/historyindex"
+	"github.com/shatll-s/nexelliad/domain/subnetworkindex"
+	"github.com/shatll-s/nexelliad/domain/txindex"
 	"github.com/shatll-s/nexelliad/domain/utxoindex"
 	"github.com/shatll-s/nexelliad/infrastructure/config"
 	"github.com/shatll-s/nexelliad/infrastructure/network/addressmanager"
@@ -19,9 +22,13 @@ type Context struct {
 	ConnectionManager *connmanager.ConnectionManager
 	AddressManager    *addressmanager.AddressManager
 	UTXOIndex         *utxoindex.UTXOIndex
+	TXIndex           *txindex.TXIndex
+	SubnetworkIndex   *subnetworkindex.SubnetworkIndex
+	HistoryIndex      *historyindex.HistoryIndex
 	ShutDownChan      chan<- struct{}
 
 	NotificationManager *NotificationManager
+	LongPollManager     *LongPollManager
 }
 
 // NewContext creates a new RPC context
@@ -32,6 +39,9 @@ func NewContext(cfg *config.Config,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	subnetworkIndex *subnetworkindex.SubnetworkIndex,
+	historyIndex *historyindex.HistoryIndex,
 	shutDownChan chan<- struct{}) *Context {
 
 	context := &Context{
@@ -42,9 +52,13 @@ func NewContext(cfg *config.Config,
 		ConnectionManager: connectionManager,
 		AddressManager:    addressManager,
 		UTXOIndex:         utxoIndex,
+		TXIndex:           txIndex,
+		SubnetworkIndex:   subnetworkIndex,
+		HistoryIndex:      historyIndex,
 		ShutDownChan:      shutDownChan,
 	}
 	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams)
+	context.LongPollManager = NewLongPollManager()
 
 	return context
 }
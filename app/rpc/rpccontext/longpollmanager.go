@@ -0,0 +1,76 @@
+package rpccontext
+
+import (
+	"sync"
+
+	routerpkg "github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// LongPollManager keeps track of the GetBlockTemplate long polls that are yet to be
+// answered, at most one per RPC client. Long polls are answered asynchronously on the
+// same route as all the other GetBlockTemplate responses, so a client's pending long
+// poll is answered before any GetBlockTemplate request it sends later, in order for
+// the client to receive the responses in the order it sent the requests
+type LongPollManager struct {
+	sync.Mutex
+	longPolls map[*routerpkg.Router]*LongPoll
+}
+
+// LongPoll is a GetBlockTemplate long poll that is answered asynchronously
+type LongPoll struct {
+	answerNow chan struct{}
+	answered  chan struct{}
+}
+
+// NewLongPollManager creates a new LongPollManager
+func NewLongPollManager() *LongPollManager {
+	return &LongPollManager{
+		longPolls: make(map[*routerpkg.Router]*LongPoll),
+	}
+}
+
+// AnswerPending has the pending long poll of the given router, if there is one, answered
+// right away, and returns once its response was sent. It must be called by every
+// GetBlockTemplate request before it is responded to
+func (lpm *LongPollManager) AnswerPending(router *routerpkg.Router) {
+	lpm.Lock()
+	longPoll, ok := lpm.longPolls[router]
+	lpm.Unlock()
+	if !ok {
+		return
+	}
+
+	close(longPoll.answerNow)
+	<-longPoll.answered
+}
+
+// Start registers a new long poll of the given router. AnswerPending must be called
+// before it, and Done once the long poll's response was sent
+func (lpm *LongPollManager) Start(router *routerpkg.Router) *LongPoll {
+	lpm.Lock()
+	defer lpm.Unlock()
+
+	longPoll := &LongPoll{
+		answerNow: make(chan struct{}),
+		answered:  make(chan struct{}),
+	}
+	lpm.longPolls[router] = longPoll
+	return longPoll
+}
+
+// Done marks the given long poll of the given router as answered
+func (lpm *LongPollManager) Done(router *routerpkg.Router, longPoll *LongPoll) {
+	lpm.Lock()
+	defer lpm.Unlock()
+
+	if lpm.longPolls[router] == longPoll {
+		delete(lpm.longPolls, router)
+	}
+	close(longPoll.answered)
+}
+
+// AnswerNow returns a channel that is closed once the long poll has to be answered
+// right away, because the same client sent another GetBlockTemplate request
+func (lp *LongPoll) AnswerNow() <-chan struct{} {
+	return lp.answerNow
+}
@@ -0,0 +1,42 @@
+package rpccontext
+
+import (
+	"testing"
+	"time"
+
+	routerpkg "github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+func TestLongPollManager(t *testing.T) {
+	longPollManager := NewLongPollManager()
+	router := routerpkg.NewRouter("TestLongPollManager")
+	otherRouter := routerpkg.NewRouter("TestLongPollManager-other")
+
+	// Nothing is pending yet, so this must not block
+	longPollManager.AnswerPending(router)
+
+	var responses []string
+	longPoll := longPollManager.Start(router)
+	go func() {
+		defer longPollManager.Done(router, longPoll)
+		<-longPoll.AnswerNow()
+		responses = append(responses, "long poll")
+	}()
+
+	// The long poll of one client must not be answered by the requests of another
+	longPollManager.AnswerPending(otherRouter)
+	select {
+	case <-longPoll.AnswerNow():
+		t.Fatalf("The long poll was answered because of another client")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	longPollManager.AnswerPending(router)
+	responses = append(responses, "request")
+	if len(responses) != 2 || responses[0] != "long poll" {
+		t.Fatalf("Expected the long poll to be answered before the request, got %v", responses)
+	}
+
+	// The answered long poll is no longer pending
+	longPollManager.AnswerPending(router)
+}
@@ -41,8 +41,10 @@ type NotificationListener struct {
 	propagateVirtualDaaScoreChangedNotifications                bool
 	propagatePruningPointUTXOSetOverrideNotifications           bool
 	propagateNewBlockTemplateNotifications                      bool
+	propagateMempoolChangedNotifications                        bool
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
+	propagateMempoolChangedNotificationAddresses                                  map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
 }
 
@@ -301,6 +303,39 @@ func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
 	return nil
 }
 
+// HasMempoolChangedListeners indicates if the notification manager has any listeners for `MempoolChanged` events
+func (nm *NotificationManager) HasMempoolChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyMempoolChanged notifies the notification manager that the given transaction has been
+// added to or removed from the mempool. The notification is only sent to listeners that
+// registered for all mempool changes or for one of the addresses the transaction involves
+func (nm *NotificationManager) NotifyMempoolChanged(transaction *externalapi.DomainTransaction,
+	notification *appmessage.MempoolChangedNotificationMessage) error {
+
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for router, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications && listener.isInterestedInMempoolTransaction(transaction) {
+			err := router.OutgoingRoute().MaybeEnqueue(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 	return &NotificationListener{
 		params: params,
@@ -313,6 +348,7 @@ func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 		propagateVirtualSelectedParentBlueScoreChangedNotifications: false,
 		propagateNewBlockTemplateNotifications:                      false,
 		propagatePruningPointUTXOSetOverrideNotifications:           false,
+		propagateMempoolChangedNotifications:                        false,
 	}
 }
 
@@ -489,3 +525,88 @@ func (nl *NotificationListener) PropagatePruningPointUTXOSetOverrideNotification
 func (nl *NotificationListener) StopPropagatingPruningPointUTXOSetOverrideNotifications() {
 	nl.propagatePruningPointUTXOSetOverrideNotifications = false
 }
+
+// PropagateMempoolChangedNotifications instructs the listener to send mempool changed notifications
+// to the remote listener for transactions that involve the given addresses. Subsequent calls add to
+// the previously given addresses. If addresses is empty, notifications are sent for all transactions.
+func (nm *NotificationManager) PropagateMempoolChangedNotifications(nl *NotificationListener, addresses []*UTXOsChangedNotificationAddress) {
+	// Apply a write-lock since the internal listener address map is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if len(addresses) == 0 {
+		nl.propagateMempoolChangedNotifications = true
+		nl.propagateMempoolChangedNotificationAddresses = nil
+		return
+	}
+
+	if !nl.propagateMempoolChangedNotifications {
+		nl.propagateMempoolChangedNotifications = true
+		nl.propagateMempoolChangedNotificationAddresses =
+			make(map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress, len(addresses))
+	}
+
+	// A nil address map means the listener already gets notifications for all transactions
+	if nl.propagateMempoolChangedNotificationAddresses == nil {
+		return
+	}
+	for _, address := range addresses {
+		nl.propagateMempoolChangedNotificationAddresses[address.ScriptPublicKeyString] = address
+	}
+}
+
+// StopPropagatingMempoolChangedNotifications instructs the listener to stop sending mempool
+// changed notifications to the remote listener for the given addresses. If addresses is empty,
+// or no addresses remain afterwards, the notifications are stopped altogether.
+func (nm *NotificationManager) StopPropagatingMempoolChangedNotifications(nl *NotificationListener, addresses []*UTXOsChangedNotificationAddress) {
+	// Apply a write-lock since the internal listener address map is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if !nl.propagateMempoolChangedNotifications {
+		return
+	}
+
+	if len(addresses) > 0 {
+		// A nil address map means the listener gets notifications for all
+		// transactions, so there are no specific addresses to remove
+		if nl.propagateMempoolChangedNotificationAddresses == nil {
+			return
+		}
+		for _, address := range addresses {
+			delete(nl.propagateMempoolChangedNotificationAddresses, address.ScriptPublicKeyString)
+		}
+		if len(nl.propagateMempoolChangedNotificationAddresses) > 0 {
+			return
+		}
+	}
+
+	nl.propagateMempoolChangedNotifications = false
+	nl.propagateMempoolChangedNotificationAddresses = nil
+}
+
+// isInterestedInMempoolTransaction returns whether the given transaction either pays to
+// or spends from one of the addresses this listener registered for mempool notifications
+func (nl *NotificationListener) isInterestedInMempoolTransaction(transaction *externalapi.DomainTransaction) bool {
+	if nl.propagateMempoolChangedNotificationAddresses == nil {
+		return true
+	}
+
+	for _, output := range transaction.Outputs {
+		scriptPublicKeyString := utxoindex.ScriptPublicKeyString(output.ScriptPublicKey.String())
+		if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			return true
+		}
+	}
+	for _, input := range transaction.Inputs {
+		// Orphan transactions may be missing the UTXO entries of some of their inputs
+		if input.UTXOEntry == nil {
+			continue
+		}
+		scriptPublicKeyString := utxoindex.ScriptPublicKeyString(input.UTXOEntry.ScriptPublicKey().String())
+		if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			return true
+		}
+	}
+	return false
+}
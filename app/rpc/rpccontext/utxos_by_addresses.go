@@ -3,6 +3,7 @@ package rpccontext
 import (
 	"encoding/hex"
 
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
 	"github.com/shatll-s/nexelliad/util"
 	"github.com/pkg/errors"
@@ -16,23 +17,42 @@ import (
 func ConvertUTXOOutpointEntryPairsToUTXOsByAddressesEntries(address string, pairs utxoindex.UTXOOutpointEntryPairs) []*appmessage.UTXOsByAddressesEntry {
 	utxosByAddressesEntries := make([]*appmessage.UTXOsByAddressesEntry, 0, len(pairs))
 	for outpoint, utxoEntry := range pairs {
-		utxosByAddressesEntries = append(utxosByAddressesEntries, &appmessage.UTXOsByAddressesEntry{
-			Address: address,
-			Outpoint: &appmessage.RPCOutpoint{
-				TransactionID: outpoint.TransactionID.String(),
-				Index:         outpoint.Index,
-			},
-			UTXOEntry: &appmessage.RPCUTXOEntry{
-				Amount:          utxoEntry.Amount(),
-				ScriptPublicKey: &appmessage.RPCScriptPublicKey{Script: hex.EncodeToString(utxoEntry.ScriptPublicKey().Script), Version: utxoEntry.ScriptPublicKey().Version},
-				BlockDAAScore:   utxoEntry.BlockDAAScore(),
-				IsCoinbase:      utxoEntry.IsCoinbase(),
-			},
-		})
+		outpoint := outpoint
+		utxosByAddressesEntries = append(utxosByAddressesEntries, convertUTXOToUTXOsByAddressesEntry(address, &outpoint, utxoEntry))
 	}
 	return utxosByAddressesEntries
 }
 
+// ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries converts a slice of
+// OutpointAndUTXOEntryPair to a slice of UTXOsByAddressesEntry, keeping their order
+func ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries(address string,
+	pairs []*externalapi.OutpointAndUTXOEntryPair) []*appmessage.UTXOsByAddressesEntry {
+
+	utxosByAddressesEntries := make([]*appmessage.UTXOsByAddressesEntry, len(pairs))
+	for i, pair := range pairs {
+		utxosByAddressesEntries[i] = convertUTXOToUTXOsByAddressesEntry(address, pair.Outpoint, pair.UTXOEntry)
+	}
+	return utxosByAddressesEntries
+}
+
+func convertUTXOToUTXOsByAddressesEntry(address string, outpoint *externalapi.DomainOutpoint,
+	utxoEntry externalapi.UTXOEntry) *appmessage.UTXOsByAddressesEntry {
+
+	return &appmessage.UTXOsByAddressesEntry{
+		Address: address,
+		Outpoint: &appmessage.RPCOutpoint{
+			TransactionID: outpoint.TransactionID.String(),
+			Index:         outpoint.Index,
+		},
+		UTXOEntry: &appmessage.RPCUTXOEntry{
+			Amount:          utxoEntry.Amount(),
+			ScriptPublicKey: &appmessage.RPCScriptPublicKey{Script: hex.EncodeToString(utxoEntry.ScriptPublicKey().Script), Version: utxoEntry.ScriptPublicKey().Version},
+			BlockDAAScore:   utxoEntry.BlockDAAScore(),
+			IsCoinbase:      utxoEntry.IsCoinbase(),
+		},
+	}
+}
+
 // ConvertAddressStringsToUTXOsChangedNotificationAddresses converts address strings
 // to UTXOsChangedNotificationAddresses
 func (ctx *Context) ConvertAddressStringsToUTXOsChangedNotificationAddresses(
@@ -1,9 +1,13 @@
 package rpchandlers
 
 import (
+	"math"
+	"time"
+
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/processes/coinbasemanager"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionhelper"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
@@ -11,25 +15,203 @@ import (
 	"github.com/shatll-s/nexelliad/version"
 )
 
+const (
+	// maxLongPollTimeout caps the time a long poll may be held back for
+	maxLongPollTimeout = time.Minute
+
+	// longPollRebuildInterval is how often a long poll rebuilds the template while
+	// no block is added to the DAG, to catch changes in the mempool. It matches the
+	// time the mining manager caches a template for
+	longPollRebuildInterval = time.Second
+)
+
 // HandleGetBlockTemplate handles the respectively named RPC command
-func HandleGetBlockTemplate(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+func HandleGetBlockTemplate(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
 	getBlockTemplateRequest := request.(*appmessage.GetBlockTemplateRequestMessage)
 
-	payAddress, err := util.DecodeAddress(getBlockTemplateRequest.PayAddress, context.Config.ActiveNetParams.Prefix)
+	// Clients tell GetBlockTemplate responses apart only by their order, so a pending
+	// long poll of this client has to be answered before this request is
+	context.LongPollManager.AnswerPending(router)
+
+	coinbaseData, errorMessage, err := coinbaseDataFromRequest(context, getBlockTemplateRequest)
+	if err != nil {
+		return nil, err
+	}
+	if errorMessage != nil {
+		return errorMessage, nil
+	}
+
+	if getBlockTemplateRequest.LongPollTemplateID == "" {
+		return getBlockTemplate(context, coinbaseData)
+	}
+
+	longPollTemplateID, err := externalapi.NewDomainHashFromString(getBlockTemplateRequest.LongPollTemplateID)
 	if err != nil {
 		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
-		errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse long poll template ID: %s", err)
 		return errorMessage, nil
 	}
+	longPollTimeout := time.Duration(getBlockTemplateRequest.LongPollTimeoutMilliseconds) * time.Millisecond
+	if longPollTimeout > maxLongPollTimeout {
+		longPollTimeout = maxLongPollTimeout
+	}
 
-	scriptPublicKey, err := txscript.PayToAddrScript(payAddress)
+	// The long poll is answered asynchronously, so that it doesn't hold back
+	// other requests from the same client, such as block submissions
+	longPoll := context.LongPollManager.Start(router)
+	spawn("HandleGetBlockTemplate-longPoll", func() {
+		defer context.LongPollManager.Done(router, longPoll)
+
+		response, err := longPollBlockTemplate(context, coinbaseData, longPollTemplateID, longPollTimeout,
+			longPoll.AnswerNow())
+		if err != nil {
+			log.Warnf("Error long polling the block template: %s", err)
+			response = &appmessage.GetBlockTemplateResponseMessage{}
+			response.Error = appmessage.RPCErrorf("Error long polling the block template: %s", err)
+		}
+		err = router.OutgoingRoute().Enqueue(response)
+		if err != nil {
+			log.Debugf("Could not send the long polled block template: %s", err)
+		}
+	})
+	return nil, nil
+}
+
+// coinbaseDataFromRequest builds the coinbase data requested by getBlockTemplateRequest,
+// which either pays to its pay address or splits the rewards between its payouts
+func coinbaseDataFromRequest(context *rpccontext.Context, getBlockTemplateRequest *appmessage.GetBlockTemplateRequestMessage) (
+	*externalapi.DomainCoinbaseData, *appmessage.GetBlockTemplateResponseMessage, error) {
+
+	extraData := []byte(version.Version() + "/" + getBlockTemplateRequest.ExtraData)
+
+	if len(getBlockTemplateRequest.Payouts) == 0 {
+		scriptPublicKey, errorMessage, err := payToAddressScript(context, getBlockTemplateRequest.PayAddress)
+		if err != nil || errorMessage != nil {
+			return nil, errorMessage, err
+		}
+		return &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey, ExtraData: extraData}, nil, nil
+	}
+
+	if getBlockTemplateRequest.PayAddress != "" {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Pay address and payouts cannot both be set")
+		return nil, errorMessage, nil
+	}
+	if len(getBlockTemplateRequest.Payouts) > math.MaxUint8 {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Too many payouts (%d). The max allowed is %d",
+			len(getBlockTemplateRequest.Payouts), math.MaxUint8)
+		return nil, errorMessage, nil
+	}
+
+	virtualDAAScore, err := context.Domain.Consensus().GetVirtualDAAScore()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	// The template is built on top of the virtual, so its DAA score is at least that of the virtual
+	if virtualDAAScore < context.Config.NetParams().CoinbasePayoutSplittingActivationDAAScore {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Coinbase payout splitting is not active yet")
+		return nil, errorMessage, nil
 	}
 
-	coinbaseData := &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey, ExtraData: []byte(version.Version() + "/" + getBlockTemplateRequest.ExtraData)}
+	payouts := make([]*externalapi.DomainCoinbasePayout, len(getBlockTemplateRequest.Payouts))
+	for i, payout := range getBlockTemplateRequest.Payouts {
+		if payout.Weight == 0 {
+			errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Payout weights must be positive")
+			return nil, errorMessage, nil
+		}
+		scriptPublicKey, errorMessage, err := payToAddressScript(context, payout.Address)
+		if err != nil || errorMessage != nil {
+			return nil, errorMessage, err
+		}
+		payouts[i] = &externalapi.DomainCoinbasePayout{ScriptPublicKey: scriptPublicKey, Weight: payout.Weight}
+	}
+
+	coinbaseData := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: payouts[0].ScriptPublicKey,
+		ExtraData:       extraData,
+		Payouts:         payouts,
+	}
+
+	// The blue score and subsidy have a fixed length, so the payload is as long as
+	// the one the template's coinbase will have
+	payload, err := coinbasemanager.SerializeCoinbasePayload(0, coinbaseData, 0,
+		context.Config.NetParams().CoinbasePayloadScriptPublicKeyMaxLength)
+	if err != nil {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Invalid payouts: %s", err)
+		return nil, errorMessage, nil
+	}
+	maxCoinbasePayloadLength := context.Config.NetParams().MaxCoinbasePayloadLength
+	if uint64(len(payload)) > maxCoinbasePayloadLength {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The payouts don't fit in the coinbase payload: its length "+
+			"would be %d while the max allowed is %d. Use fewer payouts or shorter extra data.",
+			len(payload), maxCoinbasePayloadLength)
+		return nil, errorMessage, nil
+	}
+
+	return coinbaseData, nil, nil
+}
+
+func payToAddressScript(context *rpccontext.Context, address string) (
+	*externalapi.ScriptPublicKey, *appmessage.GetBlockTemplateResponseMessage, error) {
+
+	decodedAddress, err := util.DecodeAddress(address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
+		return nil, errorMessage, nil
+	}
+
+	scriptPublicKey, err := txscript.PayToAddrScript(decodedAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scriptPublicKey, nil, nil
+}
+
+// longPollBlockTemplate returns a block template as soon as its ID differs from
+// longPollTemplateID, or the latest template once timeout passes or answerNow is closed
+func longPollBlockTemplate(context *rpccontext.Context, coinbaseData *externalapi.DomainCoinbaseData,
+	longPollTemplateID *externalapi.DomainHash, timeout time.Duration, answerNow <-chan struct{}) (
+	*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+	rebuildTicker := time.NewTicker(longPollRebuildInterval)
+	defer rebuildTicker.Stop()
+
+	for {
+		// The channel is taken before the template is built, so that a block
+		// added to the DAG in between is not missed
+		blockTemplateCleared := context.Domain.MiningManager().BlockTemplateCleared()
+
+		response, err := getBlockTemplate(context, coinbaseData)
+		if err != nil {
+			return nil, err
+		}
+		if response.Error != nil || response.TemplateID != longPollTemplateID.String() {
+			return response, nil
+		}
+
+		select {
+		case <-blockTemplateCleared:
+		case <-rebuildTicker.C:
+		case <-timeoutTimer.C:
+			return response, nil
+		case <-answerNow:
+			return response, nil
+		}
+	}
+}
+
+func getBlockTemplate(context *rpccontext.Context, coinbaseData *externalapi.DomainCoinbaseData) (
+	*appmessage.GetBlockTemplateResponseMessage, error) {
 
-	templateBlock, isNearlySynced, err := context.Domain.MiningManager().GetBlockTemplate(coinbaseData)
+	templateBlock, templateID, isNearlySynced, err := context.Domain.MiningManager().GetBlockTemplate(coinbaseData)
 	if err != nil {
 		return nil, err
 	}
@@ -42,5 +224,6 @@ func HandleGetBlockTemplate(context *rpccontext.Context, _ *router.Router, reque
 
 	rpcBlock := appmessage.DomainBlockToRPCBlock(templateBlock)
 
-	return appmessage.NewGetBlockTemplateResponseMessage(rpcBlock, context.ProtocolManager.Context().HasPeers() && isNearlySynced), nil
+	return appmessage.NewGetBlockTemplateResponseMessage(rpcBlock, context.ProtocolManager.Context().HasPeers() && isNearlySynced,
+		templateID.String()), nil
 }
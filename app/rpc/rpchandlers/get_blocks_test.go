@@ -16,6 +16,7 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/hashes"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/testutils"
 	"github.com/shatll-s/nexelliad/domain/miningmanager"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 	"github.com/shatll-s/nexelliad/infrastructure/config"
 )
 
@@ -27,6 +28,10 @@ func (d fakeDomain) ConsensusEventsChannel() chan externalapi.ConsensusEvent {
 	panic("implement me")
 }
 
+func (d fakeDomain) MempoolEventsChannel() chan miningmanagermodel.MempoolEvent {
+	panic("implement me")
+}
+
 func (d fakeDomain) DeleteStagingConsensus() error {
 	panic("implement me")
 }
@@ -0,0 +1,27 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	feeEstimate := context.Domain.MiningManager().GetFeeEstimate()
+	targetSecondsPerBlock := context.Config.ActiveNetParams.TargetTimePerBlock.Seconds()
+
+	toRPCFeeEstimateBucket := func(bucket miningmanagermodel.FeeEstimateBucket) *appmessage.RPCFeeEstimateBucket {
+		return &appmessage.RPCFeeEstimateBucket{
+			FeeRate:          bucket.FeeRate,
+			EstimatedSeconds: float64(bucket.EstimatedBlocks) * targetSecondsPerBlock,
+		}
+	}
+
+	return appmessage.NewGetFeeEstimateResponseMessage(
+		toRPCFeeEstimateBucket(feeEstimate.Priority),
+		toRPCFeeEstimateBucket(feeEstimate.Normal),
+		toRPCFeeEstimateBucket(feeEstimate.Low),
+	), nil
+}
@@ -3,12 +3,37 @@ package rpchandlers
 import (
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
 )
 
 // HandleGetSubnetwork handles the respectively named RPC command
 func HandleGetSubnetwork(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
-	response := &appmessage.GetSubnetworkResponseMessage{}
-	response.Error = appmessage.RPCErrorf("not implemented")
-	return response, nil
+	if !context.Config.SubnetworkIndex {
+		errorMessage := &appmessage.GetSubnetworkResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when nexelliad is run without --subnetworkindex")
+		return errorMessage, nil
+	}
+
+	getSubnetworkRequest := request.(*appmessage.GetSubnetworkRequestMessage)
+
+	subnetworkID, err := subnetworks.FromString(getSubnetworkRequest.SubnetworkID)
+	if err != nil {
+		errorMessage := &appmessage.GetSubnetworkResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Subnetwork ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	subnetworkData, found, err := context.SubnetworkIndex.SubnetworkData(subnetworkID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetSubnetworkResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Subnetwork %s was not found in the subnetwork index", subnetworkID)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewGetSubnetworkResponseMessage(subnetworkData.GasLimit, subnetworkData.FirstSeenBlockHash.String(),
+		subnetworkData.IsRegistered, subnetworkData.GasUsed, subnetworkData.TransactionCount), nil
 }
@@ -0,0 +1,48 @@
+package rpchandlers
+
+import (
+	"sort"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetSubnetworks handles the respectively named RPC command
+func HandleGetSubnetworks(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.SubnetworkIndex {
+		errorMessage := &appmessage.GetSubnetworksResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when nexelliad is run without --subnetworkindex")
+		return errorMessage, nil
+	}
+
+	allSubnetworkData, err := context.SubnetworkIndex.AllSubnetworkData()
+	if err != nil {
+		return nil, err
+	}
+
+	subnetworkIDs := make([]externalapi.DomainSubnetworkID, 0, len(allSubnetworkData))
+	for subnetworkID := range allSubnetworkData {
+		subnetworkIDs = append(subnetworkIDs, subnetworkID)
+	}
+	sort.Slice(subnetworkIDs, func(i, j int) bool {
+		return subnetworks.Less(subnetworkIDs[i], subnetworkIDs[j])
+	})
+
+	rpcSubnetworks := make([]*appmessage.RPCSubnetwork, len(subnetworkIDs))
+	for i, subnetworkID := range subnetworkIDs {
+		subnetworkData := allSubnetworkData[subnetworkID]
+		rpcSubnetworks[i] = &appmessage.RPCSubnetwork{
+			SubnetworkID:       subnetworkID.String(),
+			FirstSeenBlockHash: subnetworkData.FirstSeenBlockHash.String(),
+			IsRegistered:       subnetworkData.IsRegistered,
+			GasLimit:           subnetworkData.GasLimit,
+			GasUsed:            subnetworkData.GasUsed,
+			TransactionCount:   subnetworkData.TransactionCount,
+		}
+	}
+
+	return appmessage.NewGetSubnetworksResponseMessage(rpcSubnetworks), nil
+}
@@ -0,0 +1,74 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransaction handles the respectively named RPC command
+func HandleGetTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TXIndex {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when nexelliad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionRequest := request.(*appmessage.GetTransactionRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	txAcceptanceData, found, err := context.TXIndex.TXAcceptanceData(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in the transaction index", transactionID)
+		return errorMessage, nil
+	}
+
+	block, found, err := context.Domain.Consensus().GetBlock(txAcceptanceData.IncludingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The body of block %s, which includes transaction %s, is "+
+			"not available (it was probably pruned)", txAcceptanceData.IncludingBlockHash, transactionID)
+		return errorMessage, nil
+	}
+
+	var rpcTransaction *appmessage.RPCTransaction
+	for _, transaction := range block.Transactions {
+		if consensushashing.TransactionID(transaction).Equal(transactionID) {
+			rpcTransaction = appmessage.DomainTransactionToRPCTransaction(transaction)
+			break
+		}
+	}
+	if rpcTransaction == nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in its including block %s",
+			transactionID, txAcceptanceData.IncludingBlockHash)
+		return errorMessage, nil
+	}
+	err = context.PopulateTransactionWithVerboseData(rpcTransaction, block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmations, err := context.AcceptingBlockConfirmations(txAcceptanceData.AcceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewGetTransactionResponseMessage(rpcTransaction, txAcceptanceData.IncludingBlockHash.String(),
+		txAcceptanceData.AcceptingBlockHash.String(), confirmations), nil
+}
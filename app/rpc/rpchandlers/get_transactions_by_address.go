@@ -0,0 +1,85 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
+	"github.com/shatll-s/nexelliad/domain/historyindex"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/shatll-s/nexelliad/util"
+)
+
+// HandleGetTransactionsByAddress handles the respectively named RPC command
+func HandleGetTransactionsByAddress(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.HistoryIndex {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when nexelliad is run without --historyindex")
+		return errorMessage, nil
+	}
+
+	getTransactionsByAddressRequest := request.(*appmessage.GetTransactionsByAddressRequestMessage)
+
+	address, err := util.DecodeAddress(getTransactionsByAddressRequest.Address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s",
+			getTransactionsByAddressRequest.Address, err)
+		return errorMessage, nil
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s",
+			getTransactionsByAddressRequest.Address, err)
+		return errorMessage, nil
+	}
+
+	var after *historyindex.HistoryEntry
+	if getTransactionsByAddressRequest.Cursor != nil {
+		cursor := getTransactionsByAddressRequest.Cursor
+		transactionID, err := transactionid.FromString(cursor.TransactionID)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse the cursor's transaction ID: %s", err)
+			return errorMessage, nil
+		}
+		after = &historyindex.HistoryEntry{
+			AcceptingDAAScore: cursor.AcceptingDAAScore,
+			TransactionID:     *transactionID,
+			Direction:         historyindex.Direction(cursor.Direction),
+		}
+	}
+
+	// Ask for one entry more than the limit in order
+	// to know whether another page should follow this one
+	limit := int(getTransactionsByAddressRequest.Limit)
+	pageLimit := 0
+	if limit > 0 {
+		pageLimit = limit + 1
+	}
+	historyEntries, err := context.HistoryIndex.Entries(scriptPublicKey, after, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+	isPageFull := limit > 0 && len(historyEntries) == pageLimit
+	if isPageFull {
+		historyEntries = historyEntries[:limit]
+	}
+
+	entries := make([]*appmessage.TransactionsByAddressEntry, len(historyEntries))
+	for i, historyEntry := range historyEntries {
+		entries[i] = &appmessage.TransactionsByAddressEntry{
+			TransactionID:     historyEntry.TransactionID.String(),
+			AcceptingDAAScore: historyEntry.AcceptingDAAScore,
+			Direction:         appmessage.TransactionDirection(historyEntry.Direction),
+			Amount:            historyEntry.Amount,
+		}
+	}
+
+	var nextCursor *appmessage.TransactionsByAddressEntry
+	if isPageFull {
+		nextCursor = entries[len(entries)-1]
+	}
+	return appmessage.NewGetTransactionsByAddressResponseMessage(entries, nextCursor), nil
+}
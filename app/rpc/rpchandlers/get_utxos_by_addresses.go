@@ -3,6 +3,8 @@ package rpchandlers
 import (
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
 	"github.com/shatll-s/nexelliad/util"
@@ -18,8 +20,41 @@ func HandleGetUTXOsByAddresses(context *rpccontext.Context, _ *router.Router, re
 
 	getUTXOsByAddressesRequest := request.(*appmessage.GetUTXOsByAddressesRequestMessage)
 
+	addresses := getUTXOsByAddressesRequest.Addresses
+	var after *externalapi.DomainOutpoint
+	if getUTXOsByAddressesRequest.Cursor != nil {
+		cursor := getUTXOsByAddressesRequest.Cursor
+		cursorAddressIndex := -1
+		for i, addressString := range addresses {
+			if addressString == cursor.Address {
+				cursorAddressIndex = i
+				break
+			}
+		}
+		if cursorAddressIndex == -1 {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Cursor address '%s' is not one of the requested addresses", cursor.Address)
+			return errorMessage, nil
+		}
+		if cursor.Outpoint == nil {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Cursor is missing an outpoint")
+			return errorMessage, nil
+		}
+		transactionID, err := transactionid.FromString(cursor.Outpoint.TransactionID)
+		if err != nil {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse the cursor's transaction ID: %s", err)
+			return errorMessage, nil
+		}
+		addresses = addresses[cursorAddressIndex:]
+		after = &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: cursor.Outpoint.Index}
+	}
+
+	limit := int(getUTXOsByAddressesRequest.Limit)
 	allEntries := make([]*appmessage.UTXOsByAddressesEntry, 0)
-	for _, addressString := range getUTXOsByAddressesRequest.Addresses {
+	var nextCursor *appmessage.UTXOsByAddressesCursor
+	for _, addressString := range addresses {
 		address, err := util.DecodeAddress(addressString, context.Config.ActiveNetParams.Prefix)
 		if err != nil {
 			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
@@ -32,14 +67,37 @@ func HandleGetUTXOsByAddresses(context *rpccontext.Context, _ *router.Router, re
 			errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
 			return errorMessage, nil
 		}
-		utxoOutpointEntryPairs, err := context.UTXOIndex.UTXOs(scriptPublicKey)
+
+		// Ask for one UTXO more than still fits in the page in order
+		// to know whether another page should follow this one
+		pageLimit := 0
+		if limit > 0 {
+			pageLimit = limit - len(allEntries) + 1
+		}
+		utxoOutpointEntryPairs, err := context.UTXOIndex.UTXOsPage(scriptPublicKey, after,
+			pageLimit, getUTXOsByAddressesRequest.MinimumAmount)
 		if err != nil {
 			return nil, err
 		}
-		entries := rpccontext.ConvertUTXOOutpointEntryPairsToUTXOsByAddressesEntries(addressString, utxoOutpointEntryPairs)
+		after = nil
+
+		isPageFull := limit > 0 && len(utxoOutpointEntryPairs) == pageLimit
+		if isPageFull {
+			utxoOutpointEntryPairs = utxoOutpointEntryPairs[:pageLimit-1]
+		}
+		entries := rpccontext.ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries(addressString, utxoOutpointEntryPairs)
 		allEntries = append(allEntries, entries...)
+		if isPageFull {
+			lastEntry := allEntries[len(allEntries)-1]
+			nextCursor = &appmessage.UTXOsByAddressesCursor{
+				Address:  lastEntry.Address,
+				Outpoint: lastEntry.Outpoint,
+			}
+			break
+		}
 	}
 
 	response := appmessage.NewGetUTXOsByAddressesResponseMessage(allEntries)
+	response.NextCursor = nextCursor
 	return response, nil
 }
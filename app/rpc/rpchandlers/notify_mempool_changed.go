@@ -0,0 +1,27 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleNotifyMempoolChanged handles the respectively named RPC command
+func HandleNotifyMempoolChanged(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	notifyMempoolChangedRequest := request.(*appmessage.NotifyMempoolChangedRequestMessage)
+	addresses, err := context.ConvertAddressStringsToUTXOsChangedNotificationAddresses(notifyMempoolChangedRequest.Addresses)
+	if err != nil {
+		errorMessage := appmessage.NewNotifyMempoolChangedResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("Parsing error: %s", err)
+		return errorMessage, nil
+	}
+
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	context.NotificationManager.PropagateMempoolChangedNotifications(listener, addresses)
+
+	response := appmessage.NewNotifyMempoolChangedResponseMessage()
+	return response, nil
+}
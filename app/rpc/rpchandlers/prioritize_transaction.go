@@ -0,0 +1,89 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/shatll-s/nexelliad/util"
+)
+
+// HandlePrioritizeTransaction handles the respectively named RPC command
+func HandlePrioritizeTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("PrioritizeTransaction RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewPrioritizeTransactionResponseMessage()
+		response.Error =
+			appmessage.RPCErrorf("PrioritizeTransaction RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	prioritizeTransactionRequest := request.(*appmessage.PrioritizeTransactionRequestMessage)
+
+	priorityList, ok := context.Domain.MiningManager().TransactionSelectionPolicy().(miningmanagermodel.TransactionPriorityList)
+	if !ok {
+		errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable. Run the node with " +
+			"--txselectionpolicy=prioritylist in order to pin transactions")
+		return errorMessage, nil
+	}
+
+	if (prioritizeTransactionRequest.TransactionID == "") == (prioritizeTransactionRequest.Address == "") {
+		errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Exactly one of transaction ID and address must be set")
+		return errorMessage, nil
+	}
+
+	if prioritizeTransactionRequest.TransactionID != "" {
+		transactionID, err := transactionid.FromString(prioritizeTransactionRequest.TransactionID)
+		if err != nil {
+			errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction ID: %s", err)
+			return errorMessage, nil
+		}
+		if prioritizeTransactionRequest.Unpin {
+			priorityList.UnpinTransaction(transactionID)
+			return appmessage.NewPrioritizeTransactionResponseMessage(), nil
+		}
+
+		// Transactions are unpinned when they leave the mempool, so only transactions
+		// in the mempool may be pinned. The transaction is looked up again after it's
+		// pinned, in case it left the mempool in between
+		isInMempool := func() bool {
+			_, _, found := context.Domain.MiningManager().GetTransaction(transactionID, true, true)
+			return found
+		}
+		if !isInMempool() {
+			errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction %s is not in the mempool", transactionID)
+			return errorMessage, nil
+		}
+		priorityList.PinTransaction(transactionID, prioritizeTransactionRequest.FeeDelta)
+		if !isInMempool() {
+			priorityList.UnpinTransaction(transactionID)
+			errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction %s left the mempool", transactionID)
+			return errorMessage, nil
+		}
+		return appmessage.NewPrioritizeTransactionResponseMessage(), nil
+	}
+
+	address, err := util.DecodeAddress(prioritizeTransactionRequest.Address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.PrioritizeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
+		return errorMessage, nil
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return nil, err
+	}
+	if prioritizeTransactionRequest.Unpin {
+		priorityList.UnpinScriptPublicKey(scriptPublicKey)
+	} else {
+		priorityList.PinScriptPublicKey(scriptPublicKey, prioritizeTransactionRequest.FeeDelta)
+	}
+	return appmessage.NewPrioritizeTransactionResponseMessage(), nil
+}
@@ -0,0 +1,27 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleStopNotifyingMempoolChanged handles the respectively named RPC command
+func HandleStopNotifyingMempoolChanged(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	stopNotifyingMempoolChangedRequest := request.(*appmessage.StopNotifyingMempoolChangedRequestMessage)
+	addresses, err := context.ConvertAddressStringsToUTXOsChangedNotificationAddresses(stopNotifyingMempoolChangedRequest.Addresses)
+	if err != nil {
+		errorMessage := appmessage.NewStopNotifyingMempoolChangedResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("Parsing error: %s", err)
+		return errorMessage, nil
+	}
+
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	context.NotificationManager.StopPropagatingMempoolChangedNotifications(listener, addresses)
+
+	response := appmessage.NewStopNotifyingMempoolChangedResponseMessage()
+	return response, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/app/protocol/protocolerrors"
 	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/ruleerrors"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
@@ -41,6 +42,23 @@ func HandleSubmitBlock(context *rpccontext.Context, _ *router.Router, request ap
 		}, nil
 	}
 
+	if submitBlockRequest.TemplateID != "" {
+		templateID, err := externalapi.NewDomainHashFromString(submitBlockRequest.TemplateID)
+		if err != nil {
+			return &appmessage.SubmitBlockResponseMessage{
+				Error:        appmessage.RPCErrorf("Could not parse template ID: %s", err),
+				RejectReason: appmessage.RejectReasonBlockInvalid,
+			}, nil
+		}
+		if context.Domain.MiningManager().IsBlockTemplateStale(templateID) {
+			return &appmessage.SubmitBlockResponseMessage{
+				Error: appmessage.RPCErrorf("Block rejected. Reason: block template %s is stale",
+					submitBlockRequest.TemplateID),
+				RejectReason: appmessage.RejectReasonStaleTemplate,
+			}, nil
+		}
+	}
+
 	if !submitBlockRequest.AllowNonDAABlocks {
 		virtualDAAScore, err := context.Domain.Consensus().GetVirtualDAAScore()
 		if err != nil {
@@ -0,0 +1,36 @@
+package rpchandlers
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/app/rpc/rpccontext"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+)
+
+// HandleValidateTransaction handles the respectively named RPC command
+func HandleValidateTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	validateTransactionRequest := request.(*appmessage.ValidateTransactionRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(validateTransactionRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.ValidateTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	result, err := context.Domain.MiningManager().ValidateTransaction(domainTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	inputScriptResults := make([]*appmessage.RPCInputScriptResult, len(result.InputScriptResults))
+	for i, inputScriptResult := range result.InputScriptResults {
+		inputScriptResults[i] = &appmessage.RPCInputScriptResult{
+			IsValid: inputScriptResult.IsValid,
+			Error:   inputScriptResult.Error,
+		}
+	}
+
+	response := appmessage.NewValidateTransactionResponseMessage(result.RejectReason == "", result.RejectReason,
+		result.Mass, result.Fee, result.FeeRate, result.StandardnessViolations, inputScriptResults)
+	return response, nil
+}
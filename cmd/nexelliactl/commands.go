@@ -25,6 +25,8 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.NexelliadMessage_GetVirtualSelectedParentChainFromBlockRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_ResolveFinalityConflictRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_EstimateNetworkHashesPerSecondRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_GetSubnetworkRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_GetSubnetworksRequest{}),
 
 	reflect.TypeOf(protowire.NexelliadMessage_GetBlockTemplateRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_SubmitBlockRequest{}),
@@ -32,8 +34,13 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.NexelliadMessage_GetMempoolEntryRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_GetMempoolEntriesRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_GetMempoolEntriesByAddressesRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_GetTransactionRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_GetTransactionsByAddressRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_GetFeeEstimateRequest{}),
 
 	reflect.TypeOf(protowire.NexelliadMessage_SubmitTransactionRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_ValidateTransactionRequest{}),
+	reflect.TypeOf(protowire.NexelliadMessage_PrioritizeTransactionRequest{}),
 
 	reflect.TypeOf(protowire.NexelliadMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.NexelliadMessage_GetBalanceByAddressRequest{}),
@@ -19,6 +19,7 @@ type configFlags struct {
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than nexelliactl's version'"`
 	CommandAndParameters               []string
 	config.NetworkFlags
+	config.RPCClientFlags
 }
 
 func parseConfig() (*configFlags, error) {
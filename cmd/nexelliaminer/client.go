@@ -15,15 +15,20 @@ type minerClient struct {
 	*rpcclient.RPCClient
 
 	cfg                              *configFlags
+	rpcServer                        string
 	newBlockTemplateNotificationChan chan struct{}
 }
 
 func (mc *minerClient) connect() error {
-	rpcAddress, err := mc.cfg.NetParams().NormalizeRPCServerAddress(mc.cfg.RPCServer)
+	rpcAddress, err := mc.cfg.NetParams().NormalizeRPCServerAddress(mc.rpcServer)
 	if err != nil {
 		return err
 	}
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	connectOptions, err := mc.cfg.RPCConnectOptions()
+	if err != nil {
+		return err
+	}
+	rpcClient, err := rpcclient.NewRPCClientWithOptions(rpcAddress, connectOptions)
 	if err != nil {
 		return err
 	}
@@ -31,7 +36,18 @@ func (mc *minerClient) connect() error {
 	mc.SetTimeout(minerTimeout)
 	mc.SetLogger(backendLog, logger.LevelTrace)
 
-	err = mc.RegisterForNewBlockTemplateNotifications(func(_ *appmessage.NewBlockTemplateNotificationMessage) {
+	err = mc.registerForNewBlockTemplateNotifications()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Connected to %s", rpcAddress)
+
+	return nil
+}
+
+func (mc *minerClient) registerForNewBlockTemplateNotifications() error {
+	err := mc.RegisterForNewBlockTemplateNotifications(func(_ *appmessage.NewBlockTemplateNotificationMessage) {
 		select {
 		case mc.newBlockTemplateNotificationChan <- struct{}{}:
 		default:
@@ -40,16 +56,16 @@ func (mc *minerClient) connect() error {
 	if err != nil {
 		return errors.Wrapf(err, "error requesting new-block-template notifications")
 	}
-
-	log.Infof("Connected to %s", rpcAddress)
-
 	return nil
 }
 
-func newMinerClient(cfg *configFlags) (*minerClient, error) {
+func newMinerClient(cfg *configFlags, rpcServer string,
+	newBlockTemplateNotificationChan chan struct{}) (*minerClient, error) {
+
 	minerClient := &minerClient{
 		cfg:                              cfg,
-		newBlockTemplateNotificationChan: make(chan struct{}),
+		rpcServer:                        rpcServer,
+		newBlockTemplateNotificationChan: newBlockTemplateNotificationChan,
 	}
 
 	err := minerClient.connect()
@@ -20,6 +20,7 @@ const (
 	defaultLogFilename          = "nexelliaminer.log"
 	defaultErrLogFilename       = "nexelliaminer_err.log"
 	defaultTargetBlockRateRatio = 2.0
+	defaultThreads              = 1
 )
 
 var (
@@ -32,18 +33,23 @@ var (
 
 type configFlags struct {
 	ShowVersion           bool     `short:"V" long:"version" description:"Display version information and exit"`
-	RPCServer             string   `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCServer             []string `short:"s" long:"rpcserver" description:"RPC server to connect to. Can be given multiple times, in order of priority, to fail over between nodes"`
 	MiningAddr            string   `long:"miningaddr" description:"Address to mine to"`
 	NumberOfBlocks        uint64   `short:"n" long:"numblocks" description:"Number of blocks to mine. If omitted, will mine until the process is interrupted."`
 	MineWhenNotSynced     bool     `long:"mine-when-not-synced" description:"Mine even if the node is not synced with the rest of the network."`
 	Profile               string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	TargetBlocksPerSecond *float64 `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
+	Threads               int      `long:"threads" description:"Number of mining workers to run in parallel, each over its own range of nonces"`
+	SubmitToAllNodes      bool     `long:"submit-to-all-nodes" description:"Submit found blocks to all reachable nodes, and not only to the one the block template came from"`
+	Stats                 string   `long:"stats" description:"Serve miner statistics as JSON on /stats on given port -- NOTE port must be between 1024 and 65536"`
+	JSONLog               bool     `long:"json-log" description:"Write hash rate, block submission and other events to stdout as JSON lines instead of log text"`
 	config.NetworkFlags
+	config.RPCClientFlags
 }
 
 func parseConfig() (*configFlags, error) {
 	cfg := &configFlags{
-		RPCServer: defaultRPCServer,
+		Threads: defaultThreads,
 	}
 	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
 	_, err := parser.Parse()
@@ -77,11 +83,29 @@ func parseConfig() (*configFlags, error) {
 		}
 	}
 
+	if len(cfg.RPCServer) == 0 {
+		cfg.RPCServer = []string{defaultRPCServer}
+	}
+
+	if cfg.Stats != "" {
+		statsPort, err := strconv.Atoi(cfg.Stats)
+		if err != nil || statsPort < 1024 || statsPort > 65535 {
+			return nil, errors.New("The stats port must be between 1024 and 65535")
+		}
+		if cfg.Stats == cfg.Profile {
+			return nil, errors.New("The stats port must be different from the profile port")
+		}
+	}
+
+	if cfg.Threads < 1 {
+		return nil, errors.New("--threads must be at least 1")
+	}
+
 	if cfg.MiningAddr == "" {
 		return nil, errors.New("--miningaddr is required")
 	}
 
-	initLog(defaultLogFile, defaultErrLogFile)
+	initLog(defaultLogFile, defaultErrLogFile, cfg.JSONLog)
 
 	return cfg, nil
 }
@@ -14,7 +14,9 @@ var (
 	spawn      = panics.GoroutineWrapperFunc(log)
 )
 
-func initLog(logFile, errLogFile string) {
+// initLog sets up logging to the given files, and to stdout unless jsonLog is set,
+// in which case stdout is left for the JSON events
+func initLog(logFile, errLogFile string, jsonLog bool) {
 	log.SetLevel(logger.LevelDebug)
 	err := backendLog.AddLogFile(logFile, logger.LevelTrace)
 	if err != nil {
@@ -26,10 +28,12 @@ func initLog(logFile, errLogFile string) {
 		fmt.Fprintf(os.Stderr, "Error adding log file %s as log rotator for level %s: %s", errLogFile, logger.LevelWarn, err)
 		os.Exit(1)
 	}
-	err = backendLog.AddLogWriter(os.Stdout, logger.LevelInfo)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error adding stdout to the loggerfor level %s: %s", logger.LevelWarn, err)
-		os.Exit(1)
+	if !jsonLog {
+		err = backendLog.AddLogWriter(os.Stdout, logger.LevelInfo)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error adding stdout to the loggerfor level %s: %s", logger.LevelWarn, err)
+			os.Exit(1)
+		}
 	}
 	err = backendLog.Run()
 	if err != nil {
@@ -35,20 +35,26 @@ func main() {
 		profiling.Start(cfg.Profile, log)
 	}
 
-	client, err := newMinerClient(cfg)
+	pool, err := newNodePool(cfg)
 	if err != nil {
-		panic(errors.Wrap(err, "error connecting to the RPC server"))
+		panic(errors.Wrap(err, "error connecting to the RPC servers"))
 	}
-	defer client.Disconnect()
+	defer pool.disconnect()
 
 	miningAddr, err := util.DecodeAddress(cfg.MiningAddr, cfg.ActiveNetParams.Prefix)
 	if err != nil {
 		printErrorAndExit(errors.Errorf("Error decoding mining address: %s", err))
 	}
 
+	stats := newMinerStats(pool, cfg.JSONLog)
+	if cfg.Stats != "" {
+		stats.serve(cfg.Stats)
+	}
+
 	doneChan := make(chan struct{})
 	spawn("mineLoop", func() {
-		err = mineLoop(client, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr)
+		err = mineLoop(pool, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr,
+			cfg.Threads, cfg.SubmitToAllNodes, stats)
 		if err != nil {
 			panic(errors.Wrap(err, "error in mine loop"))
 		}
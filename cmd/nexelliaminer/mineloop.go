@@ -2,8 +2,9 @@ package main
 
 import (
 	nativeerrors "errors"
+	"fmt"
 	"math/rand"
-	"sync/atomic"
+	"strings"
 	"time"
 
 	"github.com/shatll-s/nexelliad/version"
@@ -18,12 +19,10 @@ import (
 	"github.com/pkg/errors"
 )
 
-var hashesTried uint64
-
 const logHashRateInterval = 10 * time.Second
 
-func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond float64, mineWhenNotSynced bool,
-	miningAddr util.Address) error {
+func mineLoop(pool *nodePool, numberOfBlocks uint64, targetBlocksPerSecond float64, mineWhenNotSynced bool,
+	miningAddr util.Address, threads int, submitToAllNodes bool, stats *minerStats) error {
 	rand.Seed(time.Now().UnixNano()) // Seed the global concurrent-safe random source.
 
 	errChan := make(chan error)
@@ -36,9 +35,20 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	foundBlockChan := make(chan *externalapi.DomainBlock, router.DefaultMaxMessages/2)
 
 	spawn("templatesLoop", func() {
-		templatesLoop(client, miningAddr, errChan)
+		templatesLoop(pool, miningAddr, stats, errChan)
 	})
 
+	// Workers block on sending a found block until blocksLoop takes it,
+	// which is how the block rate limit below applies to all of them
+	workerFoundBlockChan := make(chan *externalapi.DomainBlock)
+	workers := newMiningWorkers(threads)
+	for _, worker := range workers {
+		worker := worker
+		spawn(fmt.Sprintf("miningWorker-%d", worker.id), func() {
+			worker.mine(mineWhenNotSynced, workerFoundBlockChan)
+		})
+	}
+
 	spawn("blocksLoop", func() {
 		const windowSize = 10
 		hasBlockRateTarget := targetBlocksPerSecond != 0
@@ -58,7 +68,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		}
 		windowStart := time.Now()
 		for blockIndex := 1; ; blockIndex++ {
-			foundBlockChan <- mineNextBlock(mineWhenNotSynced)
+			foundBlockChan <- <-workerFoundBlockChan
 			if hasBlockRateTarget {
 				<-blockTicker.C
 				if (blockIndex % windowSize) == 0 {
@@ -74,7 +84,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	spawn("handleFoundBlock", func() {
 		for i := uint64(0); numberOfBlocks == 0 || i < numberOfBlocks; i++ {
 			block := <-foundBlockChan
-			err := handleFoundBlock(client, block)
+			err := handleFoundBlock(pool, block, submitToAllNodes, stats)
 			if err != nil {
 				errChan <- err
 				return
@@ -83,7 +93,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		doneChan <- struct{}{}
 	})
 
-	logHashRate()
+	logHashRate(workers, stats)
 
 	select {
 	case err := <-errChan:
@@ -93,37 +103,69 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	}
 }
 
-func logHashRate() {
+func logHashRate(workers []*miningWorker, stats *minerStats) {
 	spawn("logHashRate", func() {
 		lastCheck := time.Now()
 		for range time.Tick(logHashRateInterval) {
-			currentHashesTried := atomic.LoadUint64(&hashesTried)
 			currentTime := time.Now()
-			kiloHashesTried := float64(currentHashesTried) / 1000.0
-			hashRate := kiloHashesTried / currentTime.Sub(lastCheck).Seconds()
-			log.Infof("Current hash rate is %.2f Khash/s", hashRate)
+			elapsed := currentTime.Sub(lastCheck)
+			elapsedSeconds := elapsed.Seconds()
 			lastCheck = currentTime
-			// subtract from hashesTried the hashes we already sampled
-			atomic.AddUint64(&hashesTried, -currentHashesTried)
+
+			totalHashesTried := uint64(0)
+			totalHashRate := 0.0
+			workerHashRates := make([]string, len(workers))
+			for i, worker := range workers {
+				hashesTried := worker.takeHashesTried()
+				totalHashesTried += hashesTried
+				hashRate := float64(hashesTried) / 1000.0 / elapsedSeconds
+				totalHashRate += hashRate
+				workerHashRates[i] = fmt.Sprintf("%d: %.2f", worker.id, hashRate)
+			}
+			stats.recordHashes(totalHashesTried, elapsed)
+			stats.logStats()
+			log.Infof("Current hash rate is %.2f Khash/s", totalHashRate)
+			if len(workers) > 1 {
+				log.Infof("Current hash rate per worker (Khash/s): %s", strings.Join(workerHashRates, ", "))
+			}
 		}
 	})
 }
 
-func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error {
+func handleFoundBlock(pool *nodePool, block *externalapi.DomainBlock, submitToAllNodes bool,
+	stats *minerStats) error {
 	blockHash := consensushashing.BlockHash(block)
+
+	client := pool.activeClient()
+	if client == nil {
+		log.Warnf("Dropping block %s since none of the RPC servers are reachable", blockHash)
+		return nil
+	}
+
+	if submitToAllNodes {
+		for _, otherClient := range pool.otherReachableClients() {
+			otherClient := otherClient
+			spawn("submitBlockToOtherNode", func() {
+				submitBlockToOtherNode(otherClient, block, blockHash)
+			})
+		}
+	}
+
 	log.Infof("Submitting block %s to %s", blockHash, client.Address())
 
 	rejectReason, err := client.SubmitBlock(block)
 	if err != nil {
 		if nativeerrors.Is(err, router.ErrTimeout) {
 			log.Warnf("Got timeout while submitting block %s to %s: %s", blockHash, client.Address(), err)
-			return client.Reconnect()
+			pool.reportFailure(client)
+			return nil
 		}
 		if nativeerrors.Is(err, router.ErrRouteClosed) {
 			log.Debugf("Got route is closed while requesting block template from %s. "+
 				"The client is most likely reconnecting", client.Address())
 			return nil
 		}
+		stats.recordBlockRejected(blockHash, client.Address(), rejectReason.String())
 		if rejectReason == appmessage.RejectReasonIsInIBD {
 			const waitTime = 1 * time.Second
 			log.Warnf("Block %s was rejected because the node is in IBD. Waiting for %s", blockHash, waitTime)
@@ -132,32 +174,23 @@ func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error
 		}
 		return errors.Wrapf(err, "Error submitting block %s to %s", blockHash, client.Address())
 	}
+	stats.recordBlockAccepted(blockHash, client.Address())
 	return nil
 }
 
-func mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
-	nonce := rand.Uint64() // Use the global concurrent-safe random source.
-	for {
-		nonce++
-		// For each nonce we try to build a block from the most up to date
-		// block template.
-		// In the rare case where the nonce space is exhausted for a specific
-		// block, it'll keep looping the nonce until a new block template
-		// is discovered.
-		block, state := getBlockForMining(mineWhenNotSynced)
-		state.Nonce = nonce
-		atomic.AddUint64(&hashesTried, 1)
-		if state.CheckProofOfWork() {
-			mutHeader := block.Header.ToMutable()
-			mutHeader.SetNonce(nonce)
-			block.Header = mutHeader.ToImmutable()
-			log.Infof("Found block %s with parents %s", consensushashing.BlockHash(block), block.Header.DirectParents())
-			return block
-		}
+// submitBlockToOtherNode submits a block to a node other than the one its template
+// came from. The block may well be rejected there, e.g. because that node is behind,
+// so errors are only logged
+func submitBlockToOtherNode(client *minerClient, block *externalapi.DomainBlock, blockHash *externalapi.DomainHash) {
+	log.Debugf("Submitting block %s to %s", blockHash, client.Address())
+
+	_, err := client.SubmitBlock(block)
+	if err != nil {
+		log.Warnf("Error submitting block %s to %s: %s", blockHash, client.Address(), err)
 	}
 }
 
-func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.State) {
+func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.State, uint64) {
 	tryCount := 0
 
 	const sleepTime = 500 * time.Millisecond
@@ -167,7 +200,7 @@ func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.S
 		tryCount++
 
 		shouldLog := (tryCount-1)%10 == 0
-		template, state, isSynced := templatemanager.Get()
+		template, state, generation, isSynced := templatemanager.Get()
 		if template == nil {
 			if shouldLog {
 				log.Info("Waiting for the initial template")
@@ -183,19 +216,21 @@ func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.S
 			continue
 		}
 
-		return template, state
+		return template, state, generation
 	}
 }
 
-func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan error) {
+func templatesLoop(pool *nodePool, miningAddr util.Address, stats *minerStats, errChan chan error) {
 	getBlockTemplate := func() {
+		client := pool.activeClient()
+		if client == nil {
+			log.Debugf("None of the RPC servers are reachable. Not requesting a block template")
+			return
+		}
 		template, err := client.GetBlockTemplate(miningAddr.String(), "nexelliaminer-"+version.Version())
 		if nativeerrors.Is(err, router.ErrTimeout) {
 			log.Warnf("Got timeout while requesting block template from %s: %s", client.Address(), err)
-			reconnectErr := client.Reconnect()
-			if reconnectErr != nil {
-				errChan <- reconnectErr
-			}
+			pool.reportFailure(client)
 			return
 		}
 		if nativeerrors.Is(err, router.ErrRouteClosed) {
@@ -212,6 +247,7 @@ func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan er
 			errChan <- errors.Wrapf(err, "Error setting block template from %s", client.Address())
 			return
 		}
+		stats.recordTemplate()
 	}
 
 	getBlockTemplate()
@@ -219,7 +255,10 @@ func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan er
 	ticker := time.NewTicker(tickerTime)
 	for {
 		select {
-		case <-client.newBlockTemplateNotificationChan:
+		case <-pool.newBlockTemplateNotificationChan:
+			getBlockTemplate()
+			ticker.Reset(tickerTime)
+		case <-pool.activeNodeChangedChan:
 			getBlockTemplate()
 			ticker.Reset(tickerTime)
 		case <-ticker.C:
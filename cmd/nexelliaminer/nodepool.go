@@ -0,0 +1,271 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const healthCheckInterval = 5 * time.Second
+
+// minerNode is one of the nodes given with --rpcserver
+type minerNode struct {
+	rpcServer string
+
+	// client is nil until the first connection to the node succeeds
+	client         *minerClient
+	isReachable    bool
+	isSynced       bool
+	isReconnecting bool
+
+	// needsRegistration is set when the connection to the node was lost, since
+	// notification registrations don't survive a reconnect
+	needsRegistration bool
+}
+
+// nodePool keeps track of the health of the nodes the miner may work with. The node
+// with the highest priority among the healthy ones is the active node, which
+// block templates are requested from and found blocks are submitted to
+type nodePool struct {
+	cfg *configFlags
+
+	lock       sync.Mutex
+	nodes      []*minerNode
+	activeNode *minerNode
+
+	// newBlockTemplateNotificationChan is shared by the clients of all nodes
+	newBlockTemplateNotificationChan chan struct{}
+	activeNodeChangedChan            chan struct{}
+}
+
+func newNodePool(cfg *configFlags) (*nodePool, error) {
+	pool := &nodePool{
+		cfg:                              cfg,
+		nodes:                            make([]*minerNode, len(cfg.RPCServer)),
+		newBlockTemplateNotificationChan: make(chan struct{}),
+		activeNodeChangedChan:            make(chan struct{}, 1),
+	}
+	for i, rpcServer := range cfg.RPCServer {
+		pool.nodes[i] = &minerNode{rpcServer: rpcServer}
+	}
+
+	pool.checkHealth()
+	if pool.activeClient() == nil {
+		return nil, errors.New("could not connect to any of the RPC servers")
+	}
+
+	spawn("nodePool.healthCheckLoop", pool.healthCheckLoop)
+
+	return pool, nil
+}
+
+// activeClient returns the client of the active node, or nil if no node is reachable
+func (np *nodePool) activeClient() *minerClient {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	if np.activeNode == nil {
+		return nil
+	}
+	return np.activeNode.client
+}
+
+// otherReachableClients returns the clients of all the reachable nodes, except for the active one
+func (np *nodePool) otherReachableClients() []*minerClient {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	var clients []*minerClient
+	for _, node := range np.nodes {
+		if node != np.activeNode && node.isReachable {
+			clients = append(clients, node.client)
+		}
+	}
+	return clients
+}
+
+// nodeStatus is the health of a node, as reported by the stats
+type nodeStatus struct {
+	RPCServer   string `json:"rpcServer"`
+	IsReachable bool   `json:"isReachable"`
+	IsSynced    bool   `json:"isSynced"`
+}
+
+// nodeStatuses returns the health of all nodes, in order of priority, and the
+// address of the active node, which is empty if no node is reachable
+func (np *nodePool) nodeStatuses() ([]nodeStatus, string) {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	statuses := make([]nodeStatus, len(np.nodes))
+	for i, node := range np.nodes {
+		statuses[i] = nodeStatus{
+			RPCServer:   node.rpcServer,
+			IsReachable: node.isReachable,
+			IsSynced:    node.isSynced,
+		}
+	}
+	activeNode := ""
+	if np.activeNode != nil {
+		activeNode = np.activeNode.rpcServer
+	}
+	return statuses, activeNode
+}
+
+func (np *nodePool) healthCheckLoop() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		np.checkHealth()
+	}
+}
+
+// checkHealth checks all nodes in parallel, since checking an unreachable node
+// takes until the RPC timeout, and then picks the active node
+func (np *nodePool) checkHealth() {
+	waitGroup := sync.WaitGroup{}
+	for _, node := range np.nodes {
+		node := node
+		waitGroup.Add(1)
+		spawn("nodePool.checkNodeHealth", func() {
+			defer waitGroup.Done()
+			np.checkNodeHealth(node)
+		})
+	}
+	waitGroup.Wait()
+
+	np.selectActiveNode()
+}
+
+func (np *nodePool) checkNodeHealth(node *minerNode) {
+	np.lock.Lock()
+	client := node.client
+	isReconnecting := node.isReconnecting
+	needsRegistration := node.needsRegistration
+	np.lock.Unlock()
+
+	if isReconnecting {
+		return
+	}
+
+	if client == nil {
+		newClient, err := newMinerClient(np.cfg, node.rpcServer, np.newBlockTemplateNotificationChan)
+		if err != nil {
+			log.Debugf("Could not connect to %s: %s", node.rpcServer, err)
+			return
+		}
+		np.lock.Lock()
+		node.client = newClient
+		np.lock.Unlock()
+		client = newClient
+	}
+
+	getInfoResponse, err := client.GetInfo()
+	if err != nil {
+		log.Warnf("Health check of %s failed: %s", client.Address(), err)
+		np.reportFailure(client)
+		return
+	}
+
+	if needsRegistration {
+		err := client.registerForNewBlockTemplateNotifications()
+		if err != nil {
+			log.Warnf("Could not register for notifications from %s: %s", client.Address(), err)
+			np.reportFailure(client)
+			return
+		}
+	}
+
+	np.lock.Lock()
+	defer np.lock.Unlock()
+	node.needsRegistration = false
+	node.isReachable = true
+	node.isSynced = getInfoResponse.IsSynced
+}
+
+// reportFailure marks the node of the given client as unreachable, switches to
+// another node if it was the active one, and reconnects to it in the background
+func (np *nodePool) reportFailure(client *minerClient) {
+	np.lock.Lock()
+	var failedNode *minerNode
+	for _, node := range np.nodes {
+		if node.client == client {
+			failedNode = node
+			break
+		}
+	}
+	if failedNode == nil || failedNode.isReconnecting {
+		np.lock.Unlock()
+		return
+	}
+	failedNode.isReachable = false
+	failedNode.isReconnecting = true
+	failedNode.needsRegistration = true
+	np.lock.Unlock()
+
+	np.selectActiveNode()
+
+	spawn("nodePool.reconnect", func() {
+		err := client.Reconnect()
+		if err != nil {
+			log.Warnf("Error reconnecting to %s: %s", client.Address(), err)
+		}
+		np.lock.Lock()
+		defer np.lock.Unlock()
+		failedNode.isReconnecting = false
+	})
+}
+
+// selectActiveNode makes the first node, in order of priority, that's reachable and
+// synced the active one. If no node is synced, the first reachable node is picked,
+// and it's up to the mining loop to decide whether to mine when not synced
+func (np *nodePool) selectActiveNode() {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	var selectedNode *minerNode
+	for _, node := range np.nodes {
+		if node.isReachable && (node.isSynced || np.cfg.MineWhenNotSynced) {
+			selectedNode = node
+			break
+		}
+	}
+	if selectedNode == nil {
+		for _, node := range np.nodes {
+			if node.isReachable {
+				selectedNode = node
+				break
+			}
+		}
+	}
+
+	if selectedNode == np.activeNode {
+		return
+	}
+	if selectedNode == nil {
+		log.Warnf("None of the RPC servers are reachable")
+	} else {
+		log.Infof("Switching to %s as the active node", selectedNode.client.Address())
+	}
+	np.activeNode = selectedNode
+
+	select {
+	case np.activeNodeChangedChan <- struct{}{}:
+	default:
+	}
+}
+
+func (np *nodePool) disconnect() {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	for _, node := range np.nodes {
+		if node.client != nil {
+			err := node.client.Close()
+			if err != nil {
+				log.Warnf("Error disconnecting from %s: %s", node.client.Address(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+const statsReadHeaderTimeout = 10 * time.Second
+
+// minerStats collects the statistics the miner reports over HTTP with --stats,
+// and as JSON lines on stdout with --json-log
+type minerStats struct {
+	pool    *nodePool
+	jsonLog bool
+
+	lock             sync.Mutex
+	startTime        time.Time
+	totalHashes      uint64
+	currentHashRate  float64
+	blocksAccepted   uint64
+	blocksRejected   uint64
+	rejectReasons    map[string]uint64
+	lastTemplateTime time.Time
+
+	jsonLogLock sync.Mutex
+}
+
+// minerStatsSnapshot is the JSON representation of minerStats. Hash rates are in Khash/s
+type minerStatsSnapshot struct {
+	UptimeSeconds      float64           `json:"uptimeSeconds"`
+	CurrentHashRate    float64           `json:"currentHashRate"`
+	AverageHashRate    float64           `json:"averageHashRate"`
+	BlocksAccepted     uint64            `json:"blocksAccepted"`
+	BlocksRejected     uint64            `json:"blocksRejected"`
+	RejectReasons      map[string]uint64 `json:"rejectReasons"`
+	TemplateAgeSeconds *float64          `json:"templateAgeSeconds"`
+	ActiveNode         string            `json:"activeNode"`
+	Nodes              []nodeStatus      `json:"nodes"`
+}
+
+type blockSubmissionEvent struct {
+	BlockHash    string `json:"blockHash"`
+	RPCServer    string `json:"rpcServer"`
+	IsAccepted   bool   `json:"isAccepted"`
+	RejectReason string `json:"rejectReason,omitempty"`
+}
+
+type jsonLogLine struct {
+	Time  time.Time   `json:"time"`
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
+
+func newMinerStats(pool *nodePool, jsonLog bool) *minerStats {
+	return &minerStats{
+		pool:          pool,
+		jsonLog:       jsonLog,
+		startTime:     time.Now(),
+		rejectReasons: make(map[string]uint64),
+	}
+}
+
+// recordHashes records the number of hashes tried over the given duration, which
+// is what the current hash rate is calculated from
+func (ms *minerStats) recordHashes(hashes uint64, elapsed time.Duration) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	ms.totalHashes += hashes
+	ms.currentHashRate = float64(hashes) / 1000.0 / elapsed.Seconds()
+}
+
+func (ms *minerStats) recordTemplate() {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	ms.lastTemplateTime = time.Now()
+}
+
+func (ms *minerStats) recordBlockAccepted(blockHash *externalapi.DomainHash, rpcServer string) {
+	ms.lock.Lock()
+	ms.blocksAccepted++
+	ms.lock.Unlock()
+
+	ms.logEvent("blockSubmitted", &blockSubmissionEvent{
+		BlockHash:  blockHash.String(),
+		RPCServer:  rpcServer,
+		IsAccepted: true,
+	})
+}
+
+func (ms *minerStats) recordBlockRejected(blockHash *externalapi.DomainHash, rpcServer string, rejectReason string) {
+	ms.lock.Lock()
+	ms.blocksRejected++
+	ms.rejectReasons[rejectReason]++
+	ms.lock.Unlock()
+
+	ms.logEvent("blockSubmitted", &blockSubmissionEvent{
+		BlockHash:    blockHash.String(),
+		RPCServer:    rpcServer,
+		IsAccepted:   false,
+		RejectReason: rejectReason,
+	})
+}
+
+func (ms *minerStats) snapshot() *minerStatsSnapshot {
+	nodes, activeNode := ms.pool.nodeStatuses()
+
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	uptime := time.Since(ms.startTime)
+	rejectReasons := make(map[string]uint64, len(ms.rejectReasons))
+	for rejectReason, count := range ms.rejectReasons {
+		rejectReasons[rejectReason] = count
+	}
+	var templateAgeSeconds *float64
+	if !ms.lastTemplateTime.IsZero() {
+		templateAge := time.Since(ms.lastTemplateTime).Seconds()
+		templateAgeSeconds = &templateAge
+	}
+
+	return &minerStatsSnapshot{
+		UptimeSeconds:      uptime.Seconds(),
+		CurrentHashRate:    ms.currentHashRate,
+		AverageHashRate:    float64(ms.totalHashes) / 1000.0 / uptime.Seconds(),
+		BlocksAccepted:     ms.blocksAccepted,
+		BlocksRejected:     ms.blocksRejected,
+		RejectReasons:      rejectReasons,
+		TemplateAgeSeconds: templateAgeSeconds,
+		ActiveNode:         activeNode,
+		Nodes:              nodes,
+	}
+}
+
+// logStats emits a snapshot of the statistics as a JSON log line
+func (ms *minerStats) logStats() {
+	if !ms.jsonLog {
+		return
+	}
+	ms.logEvent("stats", ms.snapshot())
+}
+
+// logEvent writes the given event to stdout as a single JSON line, if --json-log is set
+func (ms *minerStats) logEvent(event string, data interface{}) {
+	if !ms.jsonLog {
+		return
+	}
+
+	line, err := json.Marshal(&jsonLogLine{
+		Time:  time.Now(),
+		Event: event,
+		Data:  data,
+	})
+	if err != nil {
+		log.Errorf("Error encoding %s event: %s", event, err)
+		return
+	}
+
+	ms.jsonLogLock.Lock()
+	defer ms.jsonLogLock.Unlock()
+	os.Stdout.Write(append(line, '\n'))
+}
+
+// serve serves the statistics as JSON on /stats
+func (ms *minerStats) serve(port string) {
+	spawn("minerStats.serve", func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/stats", ms.handleStats)
+		listenAddr := net.JoinHostPort("", port)
+		httpServer := &http.Server{
+			Addr:              listenAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: statsReadHeaderTimeout,
+		}
+		log.Infof("Stats server listening on %s", listenAddr)
+		log.Error(httpServer.ListenAndServe())
+	})
+}
+
+func (ms *minerStats) handleStats(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(writer).Encode(ms.snapshot())
+	if err != nil {
+		log.Warnf("Error writing stats: %s", err)
+	}
+}
@@ -2,6 +2,7 @@ package templatemanager
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/shatll-s/nexelliad/app/appmessage"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
@@ -11,19 +12,27 @@ import (
 var currentTemplate *externalapi.DomainBlock
 var currentState *pow.State
 var isSynced bool
+var generation uint64
 var lock = &sync.Mutex{}
 
-// Get returns the template to work on
-func Get() (*externalapi.DomainBlock, *pow.State, bool) {
+// Get returns the template to work on, along with its generation
+func Get() (*externalapi.DomainBlock, *pow.State, uint64, bool) {
 	lock.Lock()
 	defer lock.Unlock()
 	// Shallow copy the block so when the user replaces the header it won't affect the template here.
 	if currentTemplate == nil {
-		return nil, nil, false
+		return nil, nil, 0, false
 	}
 	block := *currentTemplate
 	state := *currentState
-	return &block, &state, isSynced
+	return &block, &state, generation, isSynced
+}
+
+// Generation returns a number that changes every time a new template is set.
+// It does not take the lock, so that miners can cheaply check whether the
+// template they're working on is still the current one
+func Generation() uint64 {
+	return atomic.LoadUint64(&generation)
 }
 
 // Set sets the current template to work on
@@ -37,5 +46,6 @@ func Set(template *appmessage.GetBlockTemplateResponseMessage) error {
 	currentTemplate = block
 	currentState = pow.NewState(block.Header.ToMutable())
 	isSynced = template.IsSynced
+	atomic.AddUint64(&generation, 1)
 	return nil
 }
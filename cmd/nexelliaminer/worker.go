@@ -0,0 +1,95 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sync/atomic"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliaminer/templatemanager"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/pow"
+)
+
+// miningWorker mines over its own range of nonces, so that workers never
+// try the same nonce on the same template
+type miningWorker struct {
+	id          int
+	firstNonce  uint64
+	lastNonce   uint64
+	hashesTried uint64
+}
+
+// newMiningWorkers splits the nonce space into numberOfWorkers disjoint ranges,
+// and returns a worker for each of them
+func newMiningWorkers(numberOfWorkers int) []*miningWorker {
+	rangeSize := math.MaxUint64 / uint64(numberOfWorkers)
+	workers := make([]*miningWorker, numberOfWorkers)
+	for i := range workers {
+		firstNonce := uint64(i) * rangeSize
+		lastNonce := firstNonce + rangeSize - 1
+		if i == numberOfWorkers-1 {
+			lastNonce = math.MaxUint64
+		}
+		workers[i] = &miningWorker{
+			id:         i,
+			firstNonce: firstNonce,
+			lastNonce:  lastNonce,
+		}
+	}
+	return workers
+}
+
+// mine sends every block this worker finds to foundBlockChan. It never returns
+func (w *miningWorker) mine(mineWhenNotSynced bool, foundBlockChan chan<- *externalapi.DomainBlock) {
+	var block *externalapi.DomainBlock
+	var state *pow.State
+	var generation uint64
+
+	// Start from a random nonce in the range, so that miners that
+	// run in different processes are unlikely to overlap
+	nonce := w.randomNonce()
+	for {
+		// The template is only copied when it changes, rather than for every nonce.
+		// In the rare case where the nonce range is exhausted for a specific
+		// block, it'll keep looping the nonce until a new block template
+		// is discovered.
+		if block == nil || templatemanager.Generation() != generation {
+			block, state, generation = getBlockForMining(mineWhenNotSynced)
+		}
+
+		nonce = w.nextNonce(nonce)
+		state.Nonce = nonce
+		atomic.AddUint64(&w.hashesTried, 1)
+		if state.CheckProofOfWork() {
+			mutHeader := block.Header.ToMutable()
+			mutHeader.SetNonce(nonce)
+			foundBlock := *block
+			foundBlock.Header = mutHeader.ToImmutable()
+			log.Infof("Worker %d found block %s with parents %s",
+				w.id, consensushashing.BlockHash(&foundBlock), foundBlock.Header.DirectParents())
+			foundBlockChan <- &foundBlock
+		}
+	}
+}
+
+func (w *miningWorker) randomNonce() uint64 {
+	rangeSize := w.lastNonce - w.firstNonce + 1
+	if rangeSize == 0 {
+		// The range covers the whole nonce space
+		return rand.Uint64() // Use the global concurrent-safe random source.
+	}
+	return w.firstNonce + rand.Uint64()%rangeSize
+}
+
+func (w *miningWorker) nextNonce(nonce uint64) uint64 {
+	if nonce == w.lastNonce {
+		return w.firstNonce
+	}
+	return nonce + 1
+}
+
+// takeHashesTried returns the number of hashes tried since the last call
+func (w *miningWorker) takeHashesTried() uint64 {
+	return atomic.SwapUint64(&w.hashesTried, 0)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"time"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+const nodeTimeout = 10 * time.Second
+
+type nodeClient struct {
+	*rpcclient.RPCClient
+
+	cfg                              *configFlags
+	newBlockTemplateNotificationChan chan struct{}
+}
+
+func (nc *nodeClient) connect() error {
+	rpcAddress, err := nc.cfg.NetParams().NormalizeRPCServerAddress(nc.cfg.RPCServer)
+	if err != nil {
+		return err
+	}
+	connectOptions, err := nc.cfg.RPCConnectOptions()
+	if err != nil {
+		return err
+	}
+	rpcClient, err := rpcclient.NewRPCClientWithOptions(rpcAddress, connectOptions)
+	if err != nil {
+		return err
+	}
+	nc.RPCClient = rpcClient
+	nc.SetTimeout(nodeTimeout)
+	nc.SetLogger(backendLog, logger.LevelTrace)
+
+	err = nc.RegisterForNewBlockTemplateNotifications(func(_ *appmessage.NewBlockTemplateNotificationMessage) {
+		select {
+		case nc.newBlockTemplateNotificationChan <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error requesting new-block-template notifications")
+	}
+
+	log.Infof("Connected to %s", rpcAddress)
+
+	return nil
+}
+
+func newNodeClient(cfg *configFlags) (*nodeClient, error) {
+	nodeClient := &nodeClient{
+		cfg:                              cfg,
+		newBlockTemplateNotificationChan: make(chan struct{}),
+	}
+
+	err := nodeClient.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeClient, nil
+}
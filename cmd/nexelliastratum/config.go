@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shatll-s/nexelliad/infrastructure/config"
+	"github.com/shatll-s/nexelliad/util"
+	"github.com/shatll-s/nexelliad/version"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogFilename     = "nexelliastratum.log"
+	defaultErrLogFilename  = "nexelliastratum_err.log"
+	defaultListen          = "0.0.0.0:5555"
+	defaultShareDifficulty = 1.0
+	defaultExtranonceSize  = 2
+
+	// maxExtranonceSize leaves at least half of the nonce space to every worker
+	maxExtranonceSize = 4
+)
+
+var (
+	// Default configuration options
+	defaultAppDir     = util.AppDir("nexelliastratum", false)
+	defaultLogFile    = filepath.Join(defaultAppDir, defaultLogFilename)
+	defaultErrLogFile = filepath.Join(defaultAppDir, defaultErrLogFilename)
+	defaultRPCServer  = "localhost"
+)
+
+type configFlags struct {
+	ShowVersion       bool    `short:"V" long:"version" description:"Display version information and exit"`
+	RPCServer         string  `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	MiningAddr        string  `long:"miningaddr" description:"Address that the blocks found by all workers pay to"`
+	Listen            string  `long:"listen" description:"Interface/port to listen for stratum connections"`
+	ShareDifficulty   float64 `long:"share-difficulty" description:"Difficulty of the shares workers submit. A share of difficulty 1 takes 2^32 hashes to find on average"`
+	ExtranonceSize    uint8   `long:"extranonce-size" description:"Number of high bytes of the nonce that are fixed per worker, so that workers never overlap"`
+	MineWhenNotSynced bool    `long:"mine-when-not-synced" description:"Hand out jobs even if the node is not synced with the rest of the network."`
+	Profile           string  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	config.NetworkFlags
+	config.RPCClientFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		RPCServer:       defaultRPCServer,
+		Listen:          defaultListen,
+		ShareDifficulty: defaultShareDifficulty,
+		ExtranonceSize:  defaultExtranonceSize,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+
+	// Show the version and exit if the version flag was specified.
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Profile != "" {
+		profilePort, err := strconv.Atoi(cfg.Profile)
+		if err != nil || profilePort < 1024 || profilePort > 65535 {
+			return nil, errors.New("The profile port must be between 1024 and 65535")
+		}
+	}
+
+	if cfg.MiningAddr == "" {
+		return nil, errors.New("--miningaddr is required")
+	}
+
+	if cfg.ShareDifficulty <= 0 {
+		return nil, errors.New("--share-difficulty must be positive")
+	}
+
+	if cfg.ExtranonceSize > maxExtranonceSize {
+		return nil, errors.Errorf("--extranonce-size must be at most %d", maxExtranonceSize)
+	}
+
+	initLog(defaultLogFile, defaultErrLogFile)
+
+	return cfg, nil
+}
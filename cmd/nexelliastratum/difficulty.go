@@ -0,0 +1,29 @@
+package main
+
+import (
+	"math"
+	"math/big"
+)
+
+// difficultyOneTarget is the target of a share of difficulty 1, which
+// takes 2^32 hashes to find on average
+var difficultyOneTarget = new(big.Int).Lsh(big.NewInt(1), 256-32)
+
+// maxTarget is the highest value a proof of work can have
+var maxTarget = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// difficultyToTarget returns the target that a share of the given
+// difficulty must not exceed
+func difficultyToTarget(difficulty float64) *big.Int {
+	target, _ := new(big.Float).Quo(new(big.Float).SetInt(difficultyOneTarget), big.NewFloat(difficulty)).Int(nil)
+	if target.Cmp(maxTarget) > 0 {
+		return new(big.Int).Set(maxTarget)
+	}
+	return target
+}
+
+// hashesPerShare returns the average number of hashes it takes to find
+// a share of the given difficulty
+func hashesPerShare(difficulty float64) float64 {
+	return difficulty * math.Pow(2, 32)
+}
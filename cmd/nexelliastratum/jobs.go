@@ -0,0 +1,137 @@
+package main
+
+import (
+	"math/big"
+	"strconv"
+	"sync"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/pow"
+	"github.com/pkg/errors"
+)
+
+// maxJobs is the number of recent jobs that shares are still accepted for,
+// so that shares that were in flight while a new job was sent aren't lost
+const maxJobs = 8
+
+var (
+	errJobNotFound    = errors.New("job not found")
+	errDuplicateShare = errors.New("duplicate share")
+)
+
+// job is a block template handed out to workers
+type job struct {
+	id    string
+	block *externalapi.DomainBlock
+	state *pow.State
+
+	// sequence is the order in which jobs were created
+	sequence uint64
+
+	submittedNonces map[uint64]struct{}
+}
+
+// prePowHash returns the hash workers combine with the timestamp and nonce
+// in order to calculate the proof of work
+func (j *job) prePowHash() *externalapi.DomainHash {
+	return j.state.PrePowHash()
+}
+
+// blockWithNonce returns the job's block with the given nonce in its header
+func (j *job) blockWithNonce(nonce uint64) *externalapi.DomainBlock {
+	mutableHeader := j.block.Header.ToMutable()
+	mutableHeader.SetNonce(nonce)
+	block := *j.block
+	block.Header = mutableHeader.ToImmutable()
+	return &block
+}
+
+// shareResult is the outcome of checking a share against a job
+type shareResult struct {
+	isShare bool
+	isBlock bool
+}
+
+type jobManager struct {
+	lock       sync.Mutex
+	jobs       map[string]*job
+	jobIDs     []string
+	nextJobID  uint64
+	currentJob *job
+}
+
+func newJobManager() *jobManager {
+	return &jobManager{
+		jobs: make(map[string]*job),
+	}
+}
+
+// addTemplate turns a block template into a new job, and makes it the current one
+func (jm *jobManager) addTemplate(template *appmessage.GetBlockTemplateResponseMessage) (*job, error) {
+	block, err := appmessage.RPCBlockToDomainBlock(template.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	jm.lock.Lock()
+	defer jm.lock.Unlock()
+
+	newJob := &job{
+		id:              strconv.FormatUint(jm.nextJobID, 16),
+		block:           block,
+		state:           pow.NewState(block.Header.ToMutable()),
+		sequence:        jm.nextJobID,
+		submittedNonces: make(map[uint64]struct{}),
+	}
+	jm.nextJobID++
+
+	jm.jobs[newJob.id] = newJob
+	jm.jobIDs = append(jm.jobIDs, newJob.id)
+	if len(jm.jobIDs) > maxJobs {
+		delete(jm.jobs, jm.jobIDs[0])
+		jm.jobIDs = jm.jobIDs[1:]
+	}
+	jm.currentJob = newJob
+
+	return newJob, nil
+}
+
+// current returns the job workers should work on, or nil if there isn't one yet
+func (jm *jobManager) current() *job {
+	jm.lock.Lock()
+	defer jm.lock.Unlock()
+
+	return jm.currentJob
+}
+
+// checkShare checks the proof of work of the given nonce against both the share
+// target and the job's network target. Returns errJobNotFound if the job is too old,
+// and errDuplicateShare if the nonce was already submitted for this job
+func (jm *jobManager) checkShare(jobID string, nonce uint64, shareTarget *big.Int) (*job, *shareResult, error) {
+	jm.lock.Lock()
+	shareJob, ok := jm.jobs[jobID]
+	if !ok {
+		jm.lock.Unlock()
+		return nil, nil, errJobNotFound
+	}
+	if _, ok := shareJob.submittedNonces[nonce]; ok {
+		jm.lock.Unlock()
+		return nil, nil, errDuplicateShare
+	}
+	shareJob.submittedNonces[nonce] = struct{}{}
+	jm.lock.Unlock()
+
+	// The state is copied so that checking shares concurrently is safe
+	state := *shareJob.state
+	state.Nonce = nonce
+	powValue := state.CalculateProofOfWorkValue()
+
+	// A block is always a valid share, even when the network target is easier than the
+	// share target, so that the worker is credited for it
+	isBlock := powValue.Cmp(&state.Target) <= 0
+	return shareJob, &shareResult{
+		isShare: isBlock || powValue.Cmp(shareTarget) <= 0,
+		isBlock: isBlock,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "net/http/pprof"
+
+	"github.com/shatll-s/nexelliad/infrastructure/os/signal"
+	"github.com/shatll-s/nexelliad/util"
+	"github.com/shatll-s/nexelliad/util/panics"
+	"github.com/shatll-s/nexelliad/util/profiling"
+	"github.com/shatll-s/nexelliad/version"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg, err := parseConfig()
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error parsing command-line arguments: %s", err))
+	}
+	defer backendLog.Close()
+
+	// Show version at startup.
+	log.Infof("Version %s", version.Version())
+
+	// Enable http profiling server if requested.
+	if cfg.Profile != "" {
+		profiling.Start(cfg.Profile, log)
+	}
+
+	client, err := newNodeClient(cfg)
+	if err != nil {
+		panic(errors.Wrap(err, "error connecting to the RPC server"))
+	}
+	defer client.Disconnect()
+
+	miningAddr, err := util.DecodeAddress(cfg.MiningAddr, cfg.ActiveNetParams.Prefix)
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error decoding mining address: %s", err))
+	}
+
+	server := newStratumServer(cfg, client, miningAddr)
+	doneChan := make(chan struct{})
+	spawn("stratumServer", func() {
+		err := server.start()
+		if err != nil {
+			panic(errors.Wrap(err, "error in stratum server"))
+		}
+		doneChan <- struct{}{}
+	})
+
+	select {
+	case <-doneChan:
+	case <-interrupt:
+	}
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%+v\n", err)
+	os.Exit(1)
+}
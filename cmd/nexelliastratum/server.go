@@ -0,0 +1,181 @@
+package main
+
+import (
+	nativeerrors "errors"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/shatll-s/nexelliad/util"
+	"github.com/shatll-s/nexelliad/version"
+	"github.com/pkg/errors"
+)
+
+// stratumServer hands out jobs built from the node's block templates
+// to the workers connected to it, and submits the blocks they find
+type stratumServer struct {
+	cfg         *configFlags
+	client      *nodeClient
+	miningAddr  util.Address
+	jobManager  *jobManager
+	stats       *statsRegistry
+	shareTarget *big.Int
+
+	sessionsLock   sync.Mutex
+	sessions       map[*session]struct{}
+	nextExtranonce uint64
+}
+
+func newStratumServer(cfg *configFlags, client *nodeClient, miningAddr util.Address) *stratumServer {
+	return &stratumServer{
+		cfg:         cfg,
+		client:      client,
+		miningAddr:  miningAddr,
+		jobManager:  newJobManager(),
+		stats:       newStatsRegistry(),
+		shareTarget: difficultyToTarget(cfg.ShareDifficulty),
+		sessions:    make(map[*session]struct{}),
+	}
+}
+
+// start listens for stratum connections, and returns only if an error occurs
+func (s *stratumServer) start() error {
+	listener, err := net.Listen("tcp", s.cfg.Listen)
+	if err != nil {
+		return errors.Wrapf(err, "error listening on %s", s.cfg.Listen)
+	}
+	defer listener.Close()
+	log.Infof("Listening for stratum connections on %s", listener.Addr())
+
+	errChan := make(chan error)
+	spawn("templatesLoop", func() {
+		s.templatesLoop(errChan)
+	})
+	spawn("logStatsLoop", func() {
+		s.stats.logStatsLoop(s.cfg.ShareDifficulty)
+	})
+	spawn("acceptLoop", func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				errChan <- errors.Wrap(err, "error accepting a stratum connection")
+				return
+			}
+			newSession := s.addSession(conn)
+			spawn("session.handle", newSession.handle)
+			spawn("session.sendJobsLoop", newSession.sendJobsLoop)
+		}
+	})
+
+	return <-errChan
+}
+
+func (s *stratumServer) addSession(conn net.Conn) *session {
+	s.sessionsLock.Lock()
+	defer s.sessionsLock.Unlock()
+
+	newSession := newSession(s, conn, s.allocateExtranonce())
+	s.sessions[newSession] = struct{}{}
+	log.Infof("Stratum connection from %s, extranonce %s", conn.RemoteAddr(), newSession.extranonceHex())
+	return newSession
+}
+
+func (s *stratumServer) removeSession(sessionToRemove *session) {
+	s.sessionsLock.Lock()
+	defer s.sessionsLock.Unlock()
+
+	delete(s.sessions, sessionToRemove)
+}
+
+// allocateExtranonce returns the next value for the high bytes of the nonce.
+// It must be called with sessionsLock held
+func (s *stratumServer) allocateExtranonce() uint64 {
+	if s.cfg.ExtranonceSize == 0 {
+		return 0
+	}
+	extranonceSpace := uint64(1) << (8 * s.cfg.ExtranonceSize)
+	extranonce := s.nextExtranonce % extranonceSpace
+	s.nextExtranonce++
+	if s.nextExtranonce > extranonceSpace && s.nextExtranonce%extranonceSpace == 1 {
+		log.Warnf("All %d extranonces are in use. Workers might overlap, "+
+			"consider increasing --extranonce-size", extranonceSpace)
+	}
+	return extranonce
+}
+
+// broadcastJob queues the given job to be sent to all the sessions. Every session sends
+// its jobs from its own goroutine, so that a stalled worker, whose writes block until
+// they time out, neither holds back the job from other workers nor new connections
+func (s *stratumServer) broadcastJob(newJob *job) {
+	s.sessionsLock.Lock()
+	defer s.sessionsLock.Unlock()
+
+	for session := range s.sessions {
+		session.queueJob(newJob)
+	}
+}
+
+func (s *stratumServer) templatesLoop(errChan chan error) {
+	getBlockTemplate := func() {
+		template, err := s.client.GetBlockTemplate(s.miningAddr.String(), "nexelliastratum-"+version.Version())
+		if nativeerrors.Is(err, router.ErrTimeout) {
+			log.Warnf("Got timeout while requesting block template from %s: %s", s.client.Address(), err)
+			reconnectErr := s.client.Reconnect()
+			if reconnectErr != nil {
+				errChan <- reconnectErr
+			}
+			return
+		}
+		if nativeerrors.Is(err, router.ErrRouteClosed) {
+			log.Debugf("Got route is closed while requesting block template from %s. "+
+				"The client is most likely reconnecting", s.client.Address())
+			return
+		}
+		if err != nil {
+			errChan <- errors.Wrapf(err, "Error getting block template from %s", s.client.Address())
+			return
+		}
+		if !template.IsSynced && !s.cfg.MineWhenNotSynced {
+			log.Warnf("Nexelliad is not synced. Skipping current block template")
+			return
+		}
+		newJob, err := s.jobManager.addTemplate(template)
+		if err != nil {
+			errChan <- errors.Wrapf(err, "Error creating a job from the block template of %s", s.client.Address())
+			return
+		}
+		s.broadcastJob(newJob)
+	}
+
+	getBlockTemplate()
+	const tickerTime = 5 * time.Second
+	ticker := time.NewTicker(tickerTime)
+	for {
+		select {
+		case <-s.client.newBlockTemplateNotificationChan:
+			getBlockTemplate()
+			ticker.Reset(tickerTime)
+		case <-ticker.C:
+			getBlockTemplate()
+		}
+	}
+}
+
+func (s *stratumServer) submitBlock(block *externalapi.DomainBlock) {
+	blockHash := consensushashing.BlockHash(block)
+	log.Infof("Submitting block %s to %s", blockHash, s.client.Address())
+
+	rejectReason, err := s.client.SubmitBlock(block)
+	if err != nil {
+		if rejectReason == appmessage.RejectReasonIsInIBD {
+			log.Warnf("Block %s was rejected because the node is in IBD", blockHash)
+			return
+		}
+		log.Warnf("Error submitting block %s to %s: %s", blockHash, s.client.Address(), err)
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const writeTimeout = 10 * time.Second
+
+// Stratum error codes, as commonly used by stratum pools
+const (
+	errorCodeOther         = 20
+	errorCodeJobNotFound   = 21
+	errorCodeDuplicate     = 22
+	errorCodeLowDifficulty = 23
+	errorCodeUnauthorized  = 24
+	errorCodeNotSubscribed = 25
+)
+
+type stratumRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type stratumResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  []interface{}   `json:"error"`
+}
+
+type stratumNotification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+func stratumError(code int, message string) []interface{} {
+	return []interface{}{code, message, nil}
+}
+
+// session is a single stratum connection. A worker may open several of them,
+// and each is given its own extranonce
+type session struct {
+	server     *stratumServer
+	conn       net.Conn
+	extranonce uint64
+
+	writeLock sync.Mutex
+
+	// pendingJob holds the latest job that is yet to be sent by sendJobsLoop.
+	// queueLock guards queueing jobs into it, and lastQueuedJob
+	pendingJob    chan *job
+	queueLock     sync.Mutex
+	lastQueuedJob *job
+	closed        chan struct{}
+
+	// stateLock guards the fields below, which are written while handling
+	// requests and read when jobs are broadcast
+	stateLock    sync.Mutex
+	isSubscribed bool
+	workerName   string
+}
+
+func newSession(server *stratumServer, conn net.Conn, extranonce uint64) *session {
+	return &session{
+		server:     server,
+		conn:       conn,
+		extranonce: extranonce,
+		pendingJob: make(chan *job, 1),
+		closed:     make(chan struct{}),
+	}
+}
+
+func (s *session) extranonceHex() string {
+	if s.server.cfg.ExtranonceSize == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%0*x", 2*s.server.cfg.ExtranonceSize, s.extranonce)
+}
+
+// handle reads requests from the connection until it's closed
+func (s *session) handle() {
+	defer func() {
+		s.server.removeSession(s)
+		close(s.closed)
+		s.conn.Close()
+		log.Infof("Stratum connection from %s closed", s.conn.RemoteAddr())
+	}()
+
+	scanner := bufio.NewScanner(s.conn)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		request := &stratumRequest{}
+		err := json.Unmarshal([]byte(line), request)
+		if err != nil {
+			log.Warnf("Got a malformed request from %s: %s", s.conn.RemoteAddr(), err)
+			return
+		}
+		err = s.handleRequest(request)
+		if err != nil {
+			log.Warnf("Error handling %s from %s: %s", request.Method, s.conn.RemoteAddr(), err)
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Debugf("Error reading from %s: %s", s.conn.RemoteAddr(), err)
+	}
+}
+
+func (s *session) handleRequest(request *stratumRequest) error {
+	switch request.Method {
+	case "mining.subscribe":
+		return s.handleSubscribe(request)
+	case "mining.authorize":
+		return s.handleAuthorize(request)
+	case "mining.submit":
+		return s.handleSubmit(request)
+	default:
+		return s.respondError(request, errorCodeOther, fmt.Sprintf("unknown method %s", request.Method))
+	}
+}
+
+func (s *session) handleSubscribe(request *stratumRequest) error {
+	s.stateLock.Lock()
+	s.isSubscribed = true
+	s.stateLock.Unlock()
+
+	err := s.respond(request, []interface{}{true, "EthereumStratum/1.0.0"})
+	if err != nil {
+		return err
+	}
+	return s.notify("mining.set_extranonce", s.extranonceHex(), 8-int(s.server.cfg.ExtranonceSize))
+}
+
+func (s *session) handleAuthorize(request *stratumRequest) error {
+	var workerName string
+	if len(request.Params) < 1 || json.Unmarshal(request.Params[0], &workerName) != nil || workerName == "" {
+		return s.respondError(request, errorCodeOther, "expected a worker name")
+	}
+
+	s.stateLock.Lock()
+	if !s.isSubscribed {
+		s.stateLock.Unlock()
+		return s.respondError(request, errorCodeNotSubscribed, "not subscribed")
+	}
+	s.workerName = workerName
+	s.stateLock.Unlock()
+
+	log.Infof("Worker %s authorized from %s", workerName, s.conn.RemoteAddr())
+	err := s.respond(request, true)
+	if err != nil {
+		return err
+	}
+	err = s.notify("mining.set_difficulty", s.server.cfg.ShareDifficulty)
+	if err != nil {
+		return err
+	}
+	currentJob := s.server.jobManager.current()
+	if currentJob != nil {
+		s.queueJob(currentJob)
+	}
+	return nil
+}
+
+func (s *session) handleSubmit(request *stratumRequest) error {
+	s.stateLock.Lock()
+	workerName := s.workerName
+	s.stateLock.Unlock()
+	if workerName == "" {
+		return s.respondError(request, errorCodeUnauthorized, "unauthorized worker")
+	}
+
+	var params [3]string
+	if len(request.Params) < len(params) {
+		return s.respondError(request, errorCodeOther, "expected a worker name, a job ID and a nonce")
+	}
+	for i := range params {
+		err := json.Unmarshal(request.Params[i], &params[i])
+		if err != nil {
+			return s.respondError(request, errorCodeOther, "expected a worker name, a job ID and a nonce")
+		}
+	}
+	jobID, nonceHex := params[1], params[2]
+
+	nonce, err := s.parseNonce(nonceHex)
+	if err != nil {
+		s.server.stats.update(workerName, func(stats *workerStats) { stats.sharesInvalid++ })
+		return s.respondError(request, errorCodeOther, err.Error())
+	}
+
+	shareJob, result, err := s.server.jobManager.checkShare(jobID, nonce, s.server.shareTarget)
+	if err != nil {
+		if errors.Is(err, errJobNotFound) {
+			s.server.stats.update(workerName, func(stats *workerStats) { stats.sharesStale++ })
+			return s.respondError(request, errorCodeJobNotFound, "job not found")
+		}
+		if errors.Is(err, errDuplicateShare) {
+			s.server.stats.update(workerName, func(stats *workerStats) { stats.sharesDuplicate++ })
+			return s.respondError(request, errorCodeDuplicate, "duplicate share")
+		}
+		return err
+	}
+
+	if result.isBlock {
+		log.Infof("Worker %s found a block", workerName)
+		s.server.submitBlock(shareJob.blockWithNonce(nonce))
+	}
+
+	if !result.isShare {
+		s.server.stats.update(workerName, func(stats *workerStats) { stats.sharesInvalid++ })
+		return s.respondError(request, errorCodeLowDifficulty, "low difficulty share")
+	}
+
+	s.server.stats.update(workerName, func(stats *workerStats) {
+		stats.sharesAccepted++
+		stats.totalSharesAccepted++
+		if result.isBlock {
+			stats.blocksFound++
+			stats.totalBlocksFound++
+		}
+	})
+	return s.respond(request, true)
+}
+
+// parseNonce parses a nonce submitted by a worker. Workers may submit either the whole
+// nonce, which must start with their extranonce, or only the part that follows it
+func (s *session) parseNonce(nonceHex string) (uint64, error) {
+	nonceHex = strings.TrimPrefix(nonceHex, "0x")
+	extranonceSize := int(s.server.cfg.ExtranonceSize)
+	workerNonceBits := uint(8 * (8 - extranonceSize))
+
+	if len(nonceHex) == 2*(8-extranonceSize) {
+		workerNonce, err := strconv.ParseUint(nonceHex, 16, int(workerNonceBits))
+		if err != nil {
+			return 0, errors.Errorf("invalid nonce %s", nonceHex)
+		}
+		return s.extranonce<<workerNonceBits | workerNonce, nil
+	}
+
+	if len(nonceHex) != 16 {
+		return 0, errors.Errorf("invalid nonce length %d", len(nonceHex))
+	}
+	nonce, err := strconv.ParseUint(nonceHex, 16, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid nonce %s", nonceHex)
+	}
+	if extranonceSize > 0 && nonce>>workerNonceBits != s.extranonce {
+		return 0, errors.Errorf("nonce %s does not start with extranonce %s", nonceHex, s.extranonceHex())
+	}
+	return nonce, nil
+}
+
+// queueJob makes the given job the next one sendJobsLoop sends, replacing any job that
+// wasn't sent yet, since a worker only needs the latest one. Jobs that are older than
+// the last queued one are ignored, so that a job that is queued late, such as the
+// current job when a worker is authorized, can't replace a newer one. Jobs are only
+// queued for authorized workers
+func (s *session) queueJob(jobToQueue *job) {
+	s.stateLock.Lock()
+	isAuthorized := s.workerName != ""
+	s.stateLock.Unlock()
+	if !isAuthorized {
+		return
+	}
+
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+
+	if s.lastQueuedJob != nil && jobToQueue.sequence <= s.lastQueuedJob.sequence {
+		return
+	}
+	s.lastQueuedJob = jobToQueue
+
+	// Only queueJob sends into pendingJob, and only under queueLock, so once
+	// the job that wasn't sent yet is dropped there's room for the new one
+	select {
+	case <-s.pendingJob:
+	default:
+	}
+	s.pendingJob <- jobToQueue
+}
+
+// sendJobsLoop sends the jobs queued by queueJob to the worker, one at a time and in
+// order, until the session is closed
+func (s *session) sendJobsLoop() {
+	for {
+		select {
+		case jobToSend := <-s.pendingJob:
+			s.sendJob(jobToSend)
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+// sendJob sends the given job to the worker
+func (s *session) sendJob(jobToSend *job) {
+	err := s.notify("mining.notify", jobToSend.id, jobToSend.prePowHash().String(), jobToSend.state.Timestamp)
+	if err != nil {
+		log.Debugf("Error sending job %s to %s: %s", jobToSend.id, s.conn.RemoteAddr(), err)
+	}
+}
+
+func (s *session) respond(request *stratumRequest, result interface{}) error {
+	return s.write(&stratumResponse{ID: request.ID, Result: result})
+}
+
+func (s *session) respondError(request *stratumRequest, code int, message string) error {
+	return s.write(&stratumResponse{ID: request.ID, Result: nil, Error: stratumError(code, message)})
+}
+
+func (s *session) notify(method string, params ...interface{}) error {
+	return s.write(&stratumNotification{Method: method, Params: params})
+}
+
+func (s *session) write(message interface{}) error {
+	serializedMessage, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	serializedMessage = append(serializedMessage, '\n')
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	err = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+	_, err = s.conn.Write(serializedMessage)
+	return err
+}
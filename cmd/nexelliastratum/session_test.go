@@ -0,0 +1,152 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/pow"
+	"github.com/shatll-s/nexelliad/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+func TestParseNonce(t *testing.T) {
+	testSession := &session{
+		server:     &stratumServer{cfg: &configFlags{ExtranonceSize: 2}},
+		extranonce: 0xabcd,
+	}
+
+	tests := []struct {
+		nonceHex      string
+		expectedNonce uint64
+		expectedError bool
+	}{
+		{nonceHex: "0123456789ab", expectedNonce: 0xabcd0123456789ab},
+		{nonceHex: "0x0123456789ab", expectedNonce: 0xabcd0123456789ab},
+		{nonceHex: "abcd0123456789ab", expectedNonce: 0xabcd0123456789ab},
+		{nonceHex: "abce0123456789ab", expectedError: true},
+		{nonceHex: "0123", expectedError: true},
+		{nonceHex: "zz23456789ab", expectedError: true},
+	}
+	for _, test := range tests {
+		nonce, err := testSession.parseNonce(test.nonceHex)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("Expected an error parsing nonce %s", test.nonceHex)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parseNonce(%s): %s", test.nonceHex, err)
+		}
+		if nonce != test.expectedNonce {
+			t.Errorf("Unexpected nonce for %s. Want: %x, got: %x", test.nonceHex, test.expectedNonce, nonce)
+		}
+	}
+
+	testSession.server.cfg.ExtranonceSize = 0
+	nonce, err := testSession.parseNonce("abcd0123456789ab")
+	if err != nil {
+		t.Fatalf("parseNonce: %s", err)
+	}
+	if nonce != 0xabcd0123456789ab {
+		t.Errorf("Unexpected nonce without an extranonce. Want: %x, got: %x", uint64(0xabcd0123456789ab), nonce)
+	}
+}
+
+func TestCheckShare(t *testing.T) {
+	genesis := dagconfig.MainnetParams.GenesisBlock
+	jm := newJobManager()
+	testJob := &job{
+		id:              "0",
+		block:           genesis,
+		state:           pow.NewState(genesis.Header.ToMutable()),
+		submittedNonces: make(map[uint64]struct{}),
+	}
+	jm.jobs[testJob.id] = testJob
+	jm.jobIDs = []string{testJob.id}
+
+	// Every proof of work value is below the maximal target
+	_, result, err := jm.checkShare(testJob.id, 1, maxTarget)
+	if err != nil {
+		t.Fatalf("checkShare: %s", err)
+	}
+	if !result.isShare {
+		t.Fatalf("Expected a share at the maximal target")
+	}
+
+	_, _, err = jm.checkShare(testJob.id, 1, maxTarget)
+	if !errors.Is(err, errDuplicateShare) {
+		t.Fatalf("Expected a duplicate share error, got: %v", err)
+	}
+
+	// No proof of work value is below zero
+	_, result, err = jm.checkShare(testJob.id, 2, big.NewInt(0))
+	if err != nil {
+		t.Fatalf("checkShare: %s", err)
+	}
+	if result.isShare {
+		t.Fatalf("Unexpectedly got a share at a zero target")
+	}
+
+	// A block is a share even if it doesn't meet the share target
+	blockJob := &job{
+		id:              "1",
+		block:           genesis,
+		state:           pow.NewState(genesis.Header.ToMutable()),
+		submittedNonces: make(map[uint64]struct{}),
+	}
+	blockJob.state.Target.Set(maxTarget)
+	jm.jobs[blockJob.id] = blockJob
+	jm.jobIDs = append(jm.jobIDs, blockJob.id)
+	_, result, err = jm.checkShare(blockJob.id, 2, big.NewInt(0))
+	if err != nil {
+		t.Fatalf("checkShare: %s", err)
+	}
+	if !result.isBlock || !result.isShare {
+		t.Fatalf("Expected a block that doesn't meet the share target to be a share")
+	}
+
+	_, _, err = jm.checkShare("2", 3, maxTarget)
+	if !errors.Is(err, errJobNotFound) {
+		t.Fatalf("Expected a job not found error, got: %v", err)
+	}
+}
+
+func TestDifficultyToTarget(t *testing.T) {
+	if difficultyToTarget(1).Cmp(difficultyOneTarget) != 0 {
+		t.Fatalf("Unexpected target for difficulty 1")
+	}
+	expectedTarget := new(big.Int).Lsh(big.NewInt(1), 256-32-1)
+	if difficultyToTarget(2).Cmp(expectedTarget) != 0 {
+		t.Fatalf("Unexpected target for difficulty 2. Want: %s, got: %s", expectedTarget, difficultyToTarget(2))
+	}
+	if difficultyToTarget(1e-12).Cmp(maxTarget) != 0 {
+		t.Fatalf("Expected the target of a tiny difficulty to be capped at the maximal target")
+	}
+}
+
+func TestQueueJob(t *testing.T) {
+	testSession := newSession(&stratumServer{cfg: &configFlags{}}, nil, 0)
+	jobs := make([]*job, 3)
+	for i := range jobs {
+		jobs[i] = &job{id: string(rune('a' + i)), sequence: uint64(i)}
+	}
+
+	testSession.queueJob(jobs[0])
+	if len(testSession.pendingJob) != 0 {
+		t.Fatalf("Expected no job to be queued for an unauthorized worker")
+	}
+
+	testSession.workerName = "worker"
+	testSession.queueJob(jobs[1])
+	testSession.queueJob(jobs[2])
+	// A job that is queued late must not replace a newer one
+	testSession.queueJob(jobs[1])
+
+	if len(testSession.pendingJob) != 1 {
+		t.Fatalf("Expected a single pending job, got %d", len(testSession.pendingJob))
+	}
+	if pendingJob := <-testSession.pendingJob; pendingJob != jobs[2] {
+		t.Fatalf("Expected job %s to be pending, got %s", jobs[2].id, pendingJob.id)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const logStatsInterval = time.Minute
+
+// workerStats counts the shares a single worker submitted since the last
+// time statistics were logged, as well as its totals
+type workerStats struct {
+	sharesAccepted  uint64
+	sharesStale     uint64
+	sharesDuplicate uint64
+	sharesInvalid   uint64
+	blocksFound     uint64
+
+	totalSharesAccepted uint64
+	totalBlocksFound    uint64
+}
+
+// statsRegistry holds the statistics of every worker, keyed by the worker
+// name it authorized with, so that they survive reconnections
+type statsRegistry struct {
+	lock    sync.Mutex
+	workers map[string]*workerStats
+}
+
+func newStatsRegistry() *statsRegistry {
+	return &statsRegistry{
+		workers: make(map[string]*workerStats),
+	}
+}
+
+// update calls updateFunc with the stats of the given worker
+func (sr *statsRegistry) update(workerName string, updateFunc func(stats *workerStats)) {
+	sr.lock.Lock()
+	defer sr.lock.Unlock()
+
+	stats, ok := sr.workers[workerName]
+	if !ok {
+		stats = &workerStats{}
+		sr.workers[workerName] = stats
+	}
+	updateFunc(stats)
+}
+
+func (sr *statsRegistry) logStatsLoop(shareDifficulty float64) {
+	lastCheck := time.Now()
+	for range time.Tick(logStatsInterval) {
+		currentTime := time.Now()
+		sr.logStats(shareDifficulty, currentTime.Sub(lastCheck))
+		lastCheck = currentTime
+	}
+}
+
+// logStats logs the hash rate and shares of every worker, estimating the hash rate
+// from the number of shares accepted since the previous call, and resets the counters
+func (sr *statsRegistry) logStats(shareDifficulty float64, elapsed time.Duration) {
+	sr.lock.Lock()
+	defer sr.lock.Unlock()
+
+	workerNames := make([]string, 0, len(sr.workers))
+	for workerName := range sr.workers {
+		workerNames = append(workerNames, workerName)
+	}
+	sort.Strings(workerNames)
+
+	totalHashRate := 0.0
+	for _, workerName := range workerNames {
+		stats := sr.workers[workerName]
+		kiloHashes := float64(stats.sharesAccepted) * hashesPerShare(shareDifficulty) / 1000.0
+		hashRate := kiloHashes / elapsed.Seconds()
+		totalHashRate += hashRate
+
+		log.Infof("Worker %s: ~%.2f Khash/s, shares accepted: %d (total %d), stale: %d, duplicate: %d, "+
+			"invalid: %d, blocks found: %d (total %d)", workerName, hashRate, stats.sharesAccepted,
+			stats.totalSharesAccepted, stats.sharesStale, stats.sharesDuplicate, stats.sharesInvalid,
+			stats.blocksFound, stats.totalBlocksFound)
+
+		stats.sharesAccepted = 0
+		stats.sharesStale = 0
+		stats.sharesDuplicate = 0
+		stats.sharesInvalid = 0
+		stats.blocksFound = 0
+	}
+	log.Infof("Total hash rate of %d workers: ~%.2f Khash/s", len(workerNames), totalHashRate)
+}
@@ -37,7 +37,7 @@ func broadcast(conf *broadcastConfig) error {
 		transactionsHex = strings.TrimSpace(string(transactionHexBytes))
 	}
 
-	transactions, err := decodeTransactionsFromHex(transactionsHex)
+	transactions, err := decodeTransactions(transactionsHex)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
+	"github.com/pkg/errors"
+)
+
+func combine(conf *combineConfig) error {
+	encodedCopies := conf.Transactions
+	for _, transactionFile := range conf.TransactionFiles {
+		transactionBytes, err := ioutil.ReadFile(transactionFile)
+		if err != nil {
+			return errors.Wrapf(err, "Could not read transaction from %s", transactionFile)
+		}
+		encodedCopies = append(encodedCopies, string(transactionBytes))
+	}
+	if len(encodedCopies) < 2 {
+		return errors.Errorf("At least two copies of the transaction are required, " +
+			"passed through --transaction or --transaction-file")
+	}
+
+	// Each copy may hold several transactions, if the wallet had to split the payment.
+	// The transactions are combined by their position in the copies
+	copies := make([][][]byte, len(encodedCopies))
+	for i, encodedCopy := range encodedCopies {
+		var err error
+		copies[i], err = decodeTransactions(encodedCopy)
+		if err != nil {
+			return errors.Wrapf(err, "Could not decode copy #%d", i+1)
+		}
+		if len(copies[i]) != len(copies[0]) {
+			return errors.Errorf("Copy #%d has %d transactions, while copy #1 has %d",
+				i+1, len(copies[i]), len(copies[0]))
+		}
+	}
+
+	combinedTransactions := make([][]byte, len(copies[0]))
+	for i := range combinedTransactions {
+		transactionCopies := make([][]byte, len(copies))
+		for j, transactions := range copies {
+			transactionCopies[j] = transactions[i]
+		}
+
+		var err error
+		combinedTransactions[i], err = libkaspawallet.CombineSignatures(transactionCopies)
+		if err != nil {
+			return errors.Wrapf(err, "Could not combine transaction #%d", i+1)
+		}
+	}
+
+	areAllTransactionsFullySigned := true
+	for _, combinedTransaction := range combinedTransactions {
+		isFullySigned, err := libkaspawallet.IsTransactionFullySigned(combinedTransaction)
+		if err != nil {
+			return err
+		}
+		if !isFullySigned {
+			areAllTransactionsFullySigned = false
+		}
+	}
+
+	if areAllTransactionsFullySigned {
+		fmt.Fprintln(os.Stderr, "The transaction is signed and ready to broadcast")
+	} else {
+		fmt.Fprintln(os.Stderr, "Successfully combined the signatures. Use `parse --verbose` to see which are still missing")
+	}
+
+	encodedTransactions, err := encodeTransactions(combinedTransactions, conf.JSON)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encodedTransactions)
+	return nil
+}
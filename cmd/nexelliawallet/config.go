@@ -22,6 +22,11 @@ const (
 	newAddressSubCmd                = "new-address"
 	dumpUnencryptedDataSubCmd       = "dump-unencrypted-data"
 	startDaemonSubCmd               = "start-daemon"
+	historySubCmd                   = "history"
+	combineSubCmd                   = "combine"
+	utxosSubCmd                     = "utxos"
+	lockUTXOsSubCmd                 = "lock-utxos"
+	unlockUTXOsSubCmd               = "unlock-utxos"
 )
 
 const (
@@ -34,14 +39,17 @@ type configFlags struct {
 }
 
 type createConfig struct {
-	KeysFile          string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.nexelliawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\nexelliawallet\\key.json (Windows))"`
-	Password          string `long:"password" short:"p" description:"Wallet password"`
-	Yes               bool   `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
-	MinimumSignatures uint32 `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
-	NumPrivateKeys    uint32 `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
-	NumPublicKeys     uint32 `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
-	ECDSA             bool   `long:"ecdsa" description:"Create an ECDSA wallet"`
-	Import            bool   `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	KeysFile           string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.nexelliawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\nexelliawallet\\key.json (Windows))"`
+	Password           string   `long:"password" short:"p" description:"Wallet password"`
+	Yes                bool     `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
+	MinimumSignatures  uint32   `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
+	NumPrivateKeys     uint32   `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
+	NumPublicKeys      uint32   `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
+	ECDSA              bool     `long:"ecdsa" description:"Create an ECDSA wallet"`
+	Import             bool     `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	WatchOnly          bool     `long:"watch-only" description:"Create a wallet without private keys, from the extended public keys given by --xpub. It can show balances and addresses and create unsigned transactions, but not sign them"`
+	ExtendedPublicKeys []string `long:"xpub" description:"An extended public key of the watch-only wallet. Use once per cosigner"`
+	CosignerIndex      uint32   `long:"cosigner-index" description:"The cosigner index of the watch-only wallet, which must match the one of the wallet whose addresses it watches (see dump-unencrypted-data)" default:"0"`
 	config.NetworkFlags
 }
 
@@ -55,11 +63,15 @@ type sendConfig struct {
 	KeysFile                 string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.nexelliawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\nexelliawallet\\key.json (Windows))"`
 	Password                 string   `long:"password" short:"p" description:"Wallet password"`
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send nexellia to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send nexellia to"`
+	To                       []string `long:"to" description:"A public address and an amount in nexellia to send to it, separated by a colon (e.g. nexellia:qz...:1234.12345678). Use multiple times to pay several addresses in one go (mutually exclusive with --to-address)"`
+	PaymentFile              string   `long:"payment-file" description:"A CSV file with an address,amount line per payment, or a JSON file with an array of {\"address\", \"amount\"} objects, where amounts are in nexellia (mutually exclusive with --to-address)"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send nexellia from. Use multiple times to accept several addresses" required:"false"`
+	UTXOs                    []string `long:"utxo" description:"The outpoint of a UTXO to spend, in the form transactionID:index (see the utxos command). Use multiple times to give several UTXOs. If given, no other UTXOs are spent"`
 	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in nexellia (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the nexellia in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeePriority              string   `long:"fee-priority" description:"How soon the transaction should be mined: priority, normal or low. The fee rate for it is estimated by the node" default:"normal"`
 	Verbose                  bool     `long:"show-serialized" short:"s" description:"Show a list of hex encoded sent transactions"`
 	config.NetworkFlags
 }
@@ -72,33 +84,46 @@ type sweepConfig struct {
 
 type createUnsignedTransactionConfig struct {
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send nexellia to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send nexellia to"`
+	To                       []string `long:"to" description:"A public address and an amount in nexellia to send to it, separated by a colon (e.g. nexellia:qz...:1234.12345678). Use multiple times to pay several addresses in one go (mutually exclusive with --to-address)"`
+	PaymentFile              string   `long:"payment-file" description:"A CSV file with an address,amount line per payment, or a JSON file with an array of {\"address\", \"amount\"} objects, where amounts are in nexellia (mutually exclusive with --to-address)"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send nexellia from. Use multiple times to accept several addresses" required:"false"`
+	UTXOs                    []string `long:"utxo" description:"The outpoint of a UTXO to spend, in the form transactionID:index (see the utxos command). Use multiple times to give several UTXOs. If given, no other UTXOs are spent"`
 	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in nexellia (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the nexellia in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeePriority              string   `long:"fee-priority" description:"How soon the transaction should be mined: priority, normal or low. The fee rate for it is estimated by the node" default:"normal"`
+	JSON                     bool     `long:"json" description:"Encode the unsigned transaction(s) in JSON instead of hex"`
 	config.NetworkFlags
 }
 
 type signConfig struct {
 	KeysFile        string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.nexelliawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\nexelliawallet\\key.json (Windows))"`
 	Password        string `long:"password" short:"p" description:"Wallet password"`
-	Transaction     string `long:"transaction" short:"t" description:"The unsigned transaction(s) to sign on (encoded in hex)"`
-	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction(s) to sign on (encoded in hex)"`
+	Transaction     string `long:"transaction" short:"t" description:"The unsigned transaction(s) to sign on (encoded in hex or JSON)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction(s) to sign on (encoded in hex or JSON)"`
+	JSON            bool   `long:"json" description:"Encode the signed transaction(s) in JSON instead of hex"`
+	config.NetworkFlags
+}
+
+type combineConfig struct {
+	Transactions     []string `long:"transaction" short:"t" description:"A signed copy of the partially signed transaction(s) to combine (encoded in hex or JSON). Use once per copy"`
+	TransactionFiles []string `long:"transaction-file" short:"F" description:"A file containing a signed copy of the partially signed transaction(s) to combine (encoded in hex or JSON). Use once per copy"`
+	JSON             bool     `long:"json" description:"Encode the combined transaction(s) in JSON instead of hex"`
 	config.NetworkFlags
 }
 
 type broadcastConfig struct {
 	DaemonAddress    string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	Transactions     string `long:"transaction" short:"t" description:"The signed transaction to broadcast (encoded in hex)"`
-	TransactionsFile string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction to sign on (encoded in hex)"`
+	Transactions     string `long:"transaction" short:"t" description:"The signed transaction to broadcast (encoded in hex or JSON)"`
+	TransactionsFile string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction to sign on (encoded in hex or JSON)"`
 	config.NetworkFlags
 }
 
 type parseConfig struct {
-	Transaction     string `long:"transaction" short:"t" description:"The transaction to parse (encoded in hex)"`
-	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the transaction to parse (encoded in hex)"`
-	Verbose         bool   `long:"verbose" short:"v" description:"Verbose: show transaction inputs"`
+	Transaction     string `long:"transaction" short:"t" description:"The transaction to parse (encoded in hex or JSON)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the transaction to parse (encoded in hex or JSON)"`
+	Verbose         bool   `long:"verbose" short:"v" description:"Verbose: show transaction inputs and which cosigners signed them"`
 	config.NetworkFlags
 }
 
@@ -112,6 +137,33 @@ type newAddressConfig struct {
 	config.NetworkFlags
 }
 
+type utxosConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Addresses     []string `long:"address" short:"a" description:"Only show the UTXOs of this address. Use multiple times to show several addresses"`
+	config.NetworkFlags
+}
+
+type lockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" short:"u" description:"The outpoint of a UTXO to lock, in the form transactionID:index. Use multiple times to lock several UTXOs" required:"true"`
+	Label         string   `long:"label" short:"l" description:"A note on why the UTXOs are locked, such as \"cold storage\""`
+	config.NetworkFlags
+}
+
+type unlockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" short:"u" description:"The outpoint of a UTXO to unlock, in the form transactionID:index. Use multiple times to unlock several UTXOs" required:"true"`
+	config.NetworkFlags
+}
+
+type historyConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TransactionID string `long:"txid" short:"t" description:"Show the details of the transaction with this ID"`
+	Address       string `long:"address" short:"a" description:"Only show transactions with this counterparty or wallet address"`
+	Limit         uint32 `long:"limit" short:"n" description:"The maximum number of transactions to show, the most recent first. 0 means no limit" default:"20"`
+	config.NetworkFlags
+}
+
 type startDaemonConfig struct {
 	KeysFile  string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.nexelliawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\nexelliawallet\\key.json (Windows))"`
 	Password  string `long:"password" short:"p" description:"Wallet password"`
@@ -120,6 +172,7 @@ type startDaemonConfig struct {
 	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
 	Profile   string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	config.NetworkFlags
+	config.RPCClientFlags
 }
 
 type dumpUnencryptedDataConfig struct {
@@ -159,6 +212,11 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(signSubCmd, "Sign the given partially signed transaction",
 		"Sign the given partially signed transaction", signConf)
 
+	combineConf := &combineConfig{}
+	parser.AddCommand(combineSubCmd, "Combine the signatures of several signed copies of the given partially signed transaction",
+		"Combine the signatures of several copies of the same partially signed transaction, each signed independently "+
+			"by some of the cosigners, into a single copy", combineConf)
+
 	broadcastConf := &broadcastConfig{DaemonAddress: defaultListen}
 	parser.AddCommand(broadcastSubCmd, "Broadcast the given transaction",
 		"Broadcast the given transaction", broadcastConf)
@@ -175,6 +233,24 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(newAddressSubCmd, "Generates new public address of the current wallet and shows it",
 		"Generates new public address of the current wallet and shows it", newAddressConf)
 
+	historyConf := &historyConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(historySubCmd, "Shows the transactions of the current wallet",
+		"Shows the transactions the wallet daemon observed or broadcast, the most recent first", historyConf)
+
+	utxosConf := &utxosConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(utxosSubCmd, "Shows the UTXOs of the current wallet",
+		"Shows the UTXOs of the current wallet, along with whether they're mature, locked, "+
+			"or spent by a pending transaction", utxosConf)
+
+	lockUTXOsConf := &lockUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(lockUTXOsSubCmd, "Locks the given UTXOs so that they're never spent",
+		"Locks the given UTXOs, so that the wallet never spends them until they're unlocked. "+
+			"The locks are kept next to the keys file", lockUTXOsConf)
+
+	unlockUTXOsConf := &unlockUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(unlockUTXOsSubCmd, "Unlocks the given UTXOs",
+		"Unlocks the given UTXOs, so that the wallet may spend them again", unlockUTXOsConf)
+
 	dumpUnencryptedDataConf := &dumpUnencryptedDataConfig{}
 	parser.AddCommand(dumpUnencryptedDataSubCmd, "Prints the unencrypted wallet data",
 		"Prints the unencrypted wallet data including its private keys. Anyone that sees it can access "+
@@ -204,6 +280,10 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		if err != nil {
 			printErrorAndExit(err)
 		}
+		err = validateCreateConfig(createConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
 		config = createConf
 	case balanceSubCmd:
 		combineNetworkFlags(&balanceConf.NetworkFlags, &cfg.NetworkFlags)
@@ -248,6 +328,13 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = signConf
+	case combineSubCmd:
+		combineNetworkFlags(&combineConf.NetworkFlags, &cfg.NetworkFlags)
+		err := combineConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = combineConf
 	case broadcastSubCmd:
 		combineNetworkFlags(&broadcastConf.NetworkFlags, &cfg.NetworkFlags)
 		err := broadcastConf.ResolveNetwork(parser)
@@ -276,6 +363,34 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = newAddressConf
+	case historySubCmd:
+		combineNetworkFlags(&historyConf.NetworkFlags, &cfg.NetworkFlags)
+		err := historyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = historyConf
+	case utxosSubCmd:
+		combineNetworkFlags(&utxosConf.NetworkFlags, &cfg.NetworkFlags)
+		err := utxosConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = utxosConf
+	case lockUTXOsSubCmd:
+		combineNetworkFlags(&lockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := lockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = lockUTXOsConf
+	case unlockUTXOsSubCmd:
+		combineNetworkFlags(&unlockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := unlockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = unlockUTXOsConf
 	case dumpUnencryptedDataSubCmd:
 		combineNetworkFlags(&dumpUnencryptedDataConf.NetworkFlags, &cfg.NetworkFlags)
 		err := dumpUnencryptedDataConf.ResolveNetwork(parser)
@@ -295,18 +410,55 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	return parser.Command.Active.Name, config
 }
 
-func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == 0) ||
-		(conf.IsSendAll && conf.SendAmount > 0) {
+func validateCreateConfig(conf *createConfig) error {
+	if !conf.WatchOnly {
+		if len(conf.ExtendedPublicKeys) > 0 {
+			return errors.New("--xpub can only be used with --watch-only")
+		}
+		return nil
+	}
 
-		return errors.New("exactly one of '--send-amount' or '--all' must be specified")
+	if conf.Import {
+		return errors.New("--watch-only and --import cannot be used together")
+	}
+	if len(conf.ExtendedPublicKeys) == 0 {
+		return errors.New("--watch-only requires at least one --xpub")
+	}
+	numPublicKeys := uint32(len(conf.ExtendedPublicKeys))
+	if conf.MinimumSignatures == 0 || conf.MinimumSignatures > numPublicKeys {
+		return errors.Errorf("--min-signatures must be between 1 and the number of extended public keys (%d)",
+			numPublicKeys)
+	}
+	if conf.CosignerIndex >= numPublicKeys {
+		return errors.Errorf("--cosigner-index must be lower than the number of extended public keys (%d)",
+			numPublicKeys)
 	}
 	return nil
 }
 
+func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
+	return validatePaymentFlags(conf.ToAddress, conf.To, conf.PaymentFile, conf.SendAmount, conf.IsSendAll)
+}
+
 func validateSendConfig(conf *sendConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == 0) ||
-		(conf.IsSendAll && conf.SendAmount > 0) {
+	return validatePaymentFlags(conf.ToAddress, conf.To, conf.PaymentFile, conf.SendAmount, conf.IsSendAll)
+}
+
+func validatePaymentFlags(toAddress string, to []string, paymentFile string, sendAmount float64, isSendAll bool) error {
+	hasPayments := len(to) > 0 || paymentFile != ""
+	if (toAddress == "") == !hasPayments {
+		return errors.New("exactly one of '--to-address' or '--to'/'--payment-file' must be specified")
+	}
+
+	if hasPayments {
+		if isSendAll || sendAmount > 0 {
+			return errors.New("'--send-amount' and '--send-all' can only be used with '--to-address'")
+		}
+		return nil
+	}
+
+	if (!isSendAll && sendAmount == 0) ||
+		(isSendAll && sendAmount > 0) {
 
 		return errors.New("exactly one of '--send-amount' or '--all' must be specified")
 	}
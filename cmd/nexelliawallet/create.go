@@ -14,6 +14,10 @@ import (
 )
 
 func create(conf *createConfig) error {
+	if conf.WatchOnly {
+		return createWatchOnly(conf)
+	}
+
 	var encryptedMnemonics []*keys.EncryptedMnemonic
 	var signerExtendedPublicKeys []string
 	var err error
@@ -64,7 +68,7 @@ func create(conf *createConfig) error {
 		}
 	}
 
-	file := keys.File{
+	file := &keys.File{
 		Version:            keys.LastVersion,
 		EncryptedMnemonics: encryptedMnemonics,
 		ExtendedPublicKeys: extendedPublicKeys,
@@ -73,7 +77,35 @@ func create(conf *createConfig) error {
 		ECDSA:              conf.ECDSA,
 	}
 
-	err = file.SetPath(conf.NetParams(), conf.KeysFile, conf.Yes)
+	return saveNewKeysFile(conf, file)
+}
+
+// createWatchOnly creates a keys file with the given extended public keys and no private keys
+func createWatchOnly(conf *createConfig) error {
+	for _, extendedPublicKey := range conf.ExtendedPublicKeys {
+		extendedKey, err := bip32.DeserializeExtendedKey(extendedPublicKey)
+		if err != nil {
+			return errors.Wrapf(err, "%s is invalid extended public key", extendedPublicKey)
+		}
+		if extendedKey.IsPrivate() {
+			return errors.Errorf("%s is an extended private key, while a watch-only wallet "+
+				"must only be given extended public keys", extendedPublicKey)
+		}
+	}
+
+	file := &keys.File{
+		Version:            keys.LastVersion,
+		ExtendedPublicKeys: conf.ExtendedPublicKeys,
+		MinimumSignatures:  conf.MinimumSignatures,
+		CosignerIndex:      conf.CosignerIndex,
+		ECDSA:              conf.ECDSA,
+	}
+
+	return saveNewKeysFile(conf, file)
+}
+
+func saveNewKeysFile(conf *createConfig, file *keys.File) error {
+	err := file.SetPath(conf.NetParams(), conf.KeysFile, conf.Yes)
 	if err != nil {
 		return err
 	}
@@ -21,19 +21,36 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 	defer cancel()
 
 	sendAmountSompi := uint64(conf.SendAmount * constants.SompiPerKaspa)
+	payments, err := parsePayments(conf.To, conf.PaymentFile)
+	if err != nil {
+		return err
+	}
+	inputs, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
 	response, err := daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 		From:                     conf.FromAddresses,
 		Address:                  conf.ToAddress,
 		Amount:                   sendAmountSompi,
+		Payments:                 payments,
+		Utxos:                    inputs,
 		IsSendAll:                conf.IsSendAll,
 		UseExistingChangeAddress: conf.UseExistingChangeAddress,
+		FeePriority:              conf.FeePriority,
 	})
 	if err != nil {
 		return err
 	}
 
+	encodedTransactions, err := encodeTransactions(response.UnsignedTransactions, conf.JSON)
+	if err != nil {
+		return err
+	}
+
 	fmt.Fprintln(os.Stderr, "Created unsigned transaction")
-	fmt.Println(encodeTransactionsToHex(response.UnsignedTransactions))
+	fmt.Println(encodedTransactions)
 
 	return nil
 }
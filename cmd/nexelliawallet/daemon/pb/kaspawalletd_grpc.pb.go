@@ -29,6 +29,11 @@ type KaspawalletdClient interface {
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetUTXOs(ctx context.Context, in *GetUTXOsRequest, opts ...grpc.CallOption) (*GetUTXOsResponse, error)
+	LockUTXOs(ctx context.Context, in *LockUTXOsRequest, opts ...grpc.CallOption) (*LockUTXOsResponse, error)
+	UnlockUTXOs(ctx context.Context, in *UnlockUTXOsRequest, opts ...grpc.CallOption) (*UnlockUTXOsResponse, error)
 }
 
 type nexelliawalletdClient struct {
@@ -120,6 +125,51 @@ func (c *nexelliawalletdClient) Sign(ctx context.Context, in *SignRequest, opts
 	return out, nil
 }
 
+func (c *nexelliawalletdClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/nexelliawalletd.nexelliawalletd/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexelliawalletdClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, "/nexelliawalletd.nexelliawalletd/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexelliawalletdClient) GetUTXOs(ctx context.Context, in *GetUTXOsRequest, opts ...grpc.CallOption) (*GetUTXOsResponse, error) {
+	out := new(GetUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/nexelliawalletd.nexelliawalletd/GetUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexelliawalletdClient) LockUTXOs(ctx context.Context, in *LockUTXOsRequest, opts ...grpc.CallOption) (*LockUTXOsResponse, error) {
+	out := new(LockUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/nexelliawalletd.nexelliawalletd/LockUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexelliawalletdClient) UnlockUTXOs(ctx context.Context, in *UnlockUTXOsRequest, opts ...grpc.CallOption) (*UnlockUTXOsResponse, error) {
+	out := new(UnlockUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/nexelliawalletd.nexelliawalletd/UnlockUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KaspawalletdServer is the server API for Kaspawalletd service.
 // All implementations must embed UnimplementedKaspawalletdServer
 // for forward compatibility
@@ -135,6 +185,11 @@ type KaspawalletdServer interface {
 	Send(context.Context, *SendRequest) (*SendResponse, error)
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetUTXOs(context.Context, *GetUTXOsRequest) (*GetUTXOsResponse, error)
+	LockUTXOs(context.Context, *LockUTXOsRequest) (*LockUTXOsResponse, error)
+	UnlockUTXOs(context.Context, *UnlockUTXOsRequest) (*UnlockUTXOsResponse, error)
 	mustEmbedUnimplementedKaspawalletdServer()
 }
 
@@ -169,6 +224,21 @@ func (UnimplementedKaspawalletdServer) Send(context.Context, *SendRequest) (*Sen
 func (UnimplementedKaspawalletdServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
 }
+func (UnimplementedKaspawalletdServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedKaspawalletdServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedKaspawalletdServer) GetUTXOs(context.Context, *GetUTXOsRequest) (*GetUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUTXOs not implemented")
+}
+func (UnimplementedKaspawalletdServer) LockUTXOs(context.Context, *LockUTXOsRequest) (*LockUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUTXOs not implemented")
+}
+func (UnimplementedKaspawalletdServer) UnlockUTXOs(context.Context, *UnlockUTXOsRequest) (*UnlockUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUTXOs not implemented")
+}
 func (UnimplementedKaspawalletdServer) mustEmbedUnimplementedKaspawalletdServer() {}
 
 // UnsafeKaspawalletdServer may be embedded to opt out of forward compatibility for this service.
@@ -344,6 +414,96 @@ func _Kaspawalletd_Sign_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Kaspawalletd_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelliawalletd.nexelliawalletd/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelliawalletd.nexelliawalletd/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_GetUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).GetUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelliawalletd.nexelliawalletd/GetUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).GetUTXOs(ctx, req.(*GetUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_LockUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).LockUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelliawalletd.nexelliawalletd/LockUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).LockUTXOs(ctx, req.(*LockUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_UnlockUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).UnlockUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelliawalletd.nexelliawalletd/UnlockUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).UnlockUTXOs(ctx, req.(*UnlockUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Kaspawalletd_ServiceDesc is the grpc.ServiceDesc for Kaspawalletd service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,6 +547,26 @@ var Kaspawalletd_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Sign",
 			Handler:    _Kaspawalletd_Sign_Handler,
 		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Kaspawalletd_GetTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Kaspawalletd_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetUTXOs",
+			Handler:    _Kaspawalletd_GetUTXOs_Handler,
+		},
+		{
+			MethodName: "LockUTXOs",
+			Handler:    _Kaspawalletd_LockUTXOs_Handler,
+		},
+		{
+			MethodName: "UnlockUTXOs",
+			Handler:    _Kaspawalletd_UnlockUTXOs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nexelliawalletd.proto",
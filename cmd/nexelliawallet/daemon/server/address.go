@@ -123,3 +123,26 @@ func (s *server) walletAddressPath(wAddr *walletAddress) string {
 func (s *server) isMultisig() bool {
 	return len(s.keysFile.ExtendedPublicKeys) > 1
 }
+
+// isWalletAddress returns whether the given address is one of the wallet's addresses
+// up to the last used index, whether it was used or not
+func (s *server) isWalletAddress(address string) (bool, error) {
+	if _, ok := s.addressSet[address]; ok {
+		return true, nil
+	}
+
+	maxUsedIndex := s.maxUsedIndex()
+	if s.nextWalletAddressIndex <= maxUsedIndex {
+		addresses, err := s.addressesToQuery(s.nextWalletAddressIndex, maxUsedIndex+1)
+		if err != nil {
+			return false, err
+		}
+		for addressString, walletAddress := range addresses {
+			s.walletAddresses[addressString] = walletAddress
+		}
+		s.nextWalletAddressIndex = maxUsedIndex + 1
+	}
+
+	_, ok := s.walletAddresses[address]
+	return ok, nil
+}
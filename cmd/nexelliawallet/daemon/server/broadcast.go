@@ -9,6 +9,8 @@ import (
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet/serialization"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
 	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient"
 	"github.com/pkg/errors"
 )
@@ -30,6 +32,9 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 	txIDs := make([]string, len(transactions))
 	var tx *externalapi.DomainTransaction
 	var err error
+	// broadcastOutputAmounts holds the amounts of the outputs of the transactions
+	// broadcast so far, which later transactions in the batch might spend
+	broadcastOutputAmounts := make(map[externalapi.DomainOutpoint]uint64)
 
 	for i, transaction := range transactions {
 
@@ -53,11 +58,16 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 		for _, input := range tx.Inputs {
 			s.usedOutpoints[input.PreviousOutpoint] = time.Now()
 		}
+
+		err = s.recordOutgoingTransaction(txIDs[i], tx, broadcastOutputAmounts)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = s.refreshUTXOs()
+	err = s.history.save()
 	if err != nil {
-		return nil, err
+		log.Errorf("Could not save the transaction history: %s", err)
 	}
 
 	return txIDs, nil
@@ -70,3 +80,62 @@ func sendTransaction(client *rpcclient.RPCClient, tx *externalapi.DomainTransact
 	}
 	return submitTransactionResponse.TransactionID, nil
 }
+
+// recordOutgoingTransaction adds the given broadcast transaction to the history. Its outputs are added to
+// broadcastOutputAmounts, and the amounts of its inputs are looked up in it if they're not in the UTXO set
+func (s *server) recordOutgoingTransaction(txID string, tx *externalapi.DomainTransaction,
+	broadcastOutputAmounts map[externalapi.DomainOutpoint]uint64) error {
+
+	inputs := make([]*externalapi.DomainOutpoint, len(tx.Inputs))
+	inputAmount := uint64(0)
+	areInputAmountsKnown := true
+	for i, input := range tx.Inputs {
+		inputs[i] = &input.PreviousOutpoint
+		if utxo, ok := s.utxosByOutpoint[input.PreviousOutpoint]; ok {
+			inputAmount += utxo.UTXOEntry.Amount()
+		} else if amount, ok := broadcastOutputAmounts[input.PreviousOutpoint]; ok {
+			inputAmount += amount
+		} else {
+			areInputAmountsKnown = false
+		}
+	}
+
+	transactionID, err := transactionid.FromString(txID)
+	if err != nil {
+		return err
+	}
+	outputAmount := uint64(0)
+	sentAmount := uint64(0)
+	var counterparties []string
+	for i, output := range tx.Outputs {
+		broadcastOutputAmounts[externalapi.DomainOutpoint{TransactionID: *transactionID, Index: uint32(i)}] = output.Value
+		outputAmount += output.Value
+
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err != nil {
+			return err
+		}
+		if address == nil {
+			// Non-standard outputs can't pay to the wallet
+			sentAmount += output.Value
+			continue
+		}
+		isWalletAddress, err := s.isWalletAddress(address.String())
+		if err != nil {
+			return err
+		}
+		if !isWalletAddress {
+			sentAmount += output.Value
+			counterparties = append(counterparties, address.String())
+		}
+	}
+
+	fee := uint64(0)
+	if areInputAmountsKnown && inputAmount >= outputAmount {
+		fee = inputAmount - outputAmount
+	} else {
+		log.Warnf("Could not calculate the fee of transaction %s, since the amounts of some of its inputs are unknown", txID)
+	}
+
+	return s.history.addOutgoingTransaction(txID, inputs, sentAmount, fee, counterparties)
+}
@@ -1,6 +1,11 @@
 package server
 
-import "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
 
 type walletUTXO struct {
 	Outpoint  *externalapi.DomainOutpoint
@@ -13,3 +18,25 @@ type walletAddress struct {
 	cosignerIndex uint32
 	keyChain      uint8
 }
+
+// writeJSONFile writes value to the given path in JSON. The file is first written under a temporary
+// name and then renamed, which is atomic, so it's never left half written
+func writeJSONFile(path string, value interface{}) error {
+	temporaryPath := path + ".tmp"
+	file, err := os.OpenFile(temporaryPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	err = json.NewEncoder(file).Encode(value)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temporaryPath, path)
+}
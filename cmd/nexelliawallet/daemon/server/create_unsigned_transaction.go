@@ -3,27 +3,35 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
 	"github.com/shatll-s/nexelliad/util"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 )
 
-// TODO: Implement a better fee estimation mechanism
-const feePerInput = 10000
-
 func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.CreateUnsignedTransactionsRequest) (
 	*pb.CreateUnsignedTransactionsResponse, error,
 ) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+	payments, err := s.decodePayments(request.Address, request.Amount, request.Payments, request.IsSendAll)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := decodeOutpoints(request.Utxos)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTransactions, err := s.createUnsignedTransactions(payments, request.IsSendAll,
+		request.From, inputs, request.UseExistingChangeAddress, request.FeePriority)
 	if err != nil {
 		return nil, err
 	}
@@ -31,21 +39,62 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	return &pb.CreateUnsignedTransactionsResponse{UnsignedTransactions: unsignedTransactions}, nil
 }
 
-func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, fromAddressesString []string, useExistingChangeAddress bool) ([][]byte, error) {
-	if !s.isSynced() {
-		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+// decodePayments decodes the payments of a request, which are given either as a single
+// address and amount, or as a list of payments. When isSendAll is set, there must be
+// exactly one payment, and its amount is ignored. Neither any single amount nor their
+// total may exceed the max sompi
+func (s *server) decodePayments(address string, amount uint64, requestPayments []*pb.Payment, isSendAll bool) (
+	[]*libkaspawallet.Payment, error) {
+
+	if address != "" || amount != 0 {
+		if len(requestPayments) > 0 {
+			return nil, errors.Errorf("a single address and amount can't be given along with a list of payments")
+		}
+		requestPayments = []*pb.Payment{{Address: address, Amount: amount}}
+	}
+	if len(requestPayments) == 0 {
+		return nil, errors.Errorf("no payments were given")
+	}
+	if isSendAll && len(requestPayments) > 1 {
+		return nil, errors.Errorf("sending all funds is only possible to a single address, but %d payments were given",
+			len(requestPayments))
 	}
 
-	// make sure address string is correct before proceeding to a
-	// potentially long UTXO refreshment operation
-	toAddress, err := util.DecodeAddress(address, s.params.Prefix)
-	if err != nil {
-		return nil, err
+	payments := make([]*libkaspawallet.Payment, len(requestPayments))
+	totalAmount := uint64(0)
+	for i, requestPayment := range requestPayments {
+		toAddress, err := util.DecodeAddress(requestPayment.Address, s.params.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		if !isSendAll && requestPayment.Amount == 0 {
+			return nil, errors.Errorf("the payment to %s has no amount", requestPayment.Address)
+		}
+		if requestPayment.Amount > constants.MaxSompi {
+			return nil, errors.Errorf("the amount of the payment to %s is %d sompi, while the max allowed is %d",
+				requestPayment.Address, requestPayment.Amount, constants.MaxSompi)
+		}
+		// Both amounts are at most MaxSompi, so adding them can't overflow
+		totalAmount += requestPayment.Amount
+		if totalAmount > constants.MaxSompi {
+			return nil, errors.Errorf("the total amount of the payments exceeds the max of %d sompi",
+				constants.MaxSompi)
+		}
+		payments[i] = &libkaspawallet.Payment{
+			Address: toAddress,
+			Amount:  requestPayment.Amount,
+		}
 	}
 
-	err = s.refreshUTXOs()
-	if err != nil {
-		return nil, err
+	return payments, nil
+}
+
+// createUnsignedTransactions creates the unsigned transactions that pay the given payments. If inputs
+// are given, only they are spent. Locked UTXOs are never spent
+func (s *server) createUnsignedTransactions(payments []*libkaspawallet.Payment, isSendAll bool, fromAddressesString []string,
+	inputs []*externalapi.DomainOutpoint, useExistingChangeAddress bool, feePriority string) ([][]byte, error) {
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
 
 	var fromAddresses []*walletAddress
@@ -57,13 +106,16 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		fromAddresses = append(fromAddresses, fromAddress)
 	}
 
-	selectedUTXOs, spendValue, changeSompi, err := s.selectUTXOs(amount, isSendAll, feePerInput, fromAddresses)
+	// Each batch gets its own transaction, so the UTXOs selected for one batch must not be selected
+	// again for the following ones. UTXOs that must not be spent at all are marked as selected up front
+	selectedOutpoints, err := s.unspendableOutpoints(inputs)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(selectedUTXOs) == 0 {
-		return nil, errors.Errorf("couldn't find funds to spend")
+	feeCalculator, err := s.newFeeCalculator(feePriority)
+	if err != nil {
+		return nil, err
 	}
 
 	changeAddress, changeWalletAddress, err := s.changeAddress(useExistingChangeAddress, fromAddresses)
@@ -71,31 +123,102 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		return nil, err
 	}
 
-	payments := []*libkaspawallet.Payment{{
-		Address: toAddress,
-		Amount:  spendValue,
-	}}
+	var unsignedTransactions [][]byte
+	for _, batch := range splitPayments(payments, feeCalculator) {
+		batchUnsignedTransactions, err := s.createUnsignedTransactionsForBatch(batch, isSendAll, fromAddresses,
+			changeAddress, changeWalletAddress, feeCalculator, selectedOutpoints)
+		if err != nil {
+			return nil, err
+		}
+		unsignedTransactions = append(unsignedTransactions, batchUnsignedTransactions...)
+	}
+
+	return unsignedTransactions, nil
+}
+
+// unspendableOutpoints returns the outpoints of the wallet's UTXOs that must not be spent: the
+// locked ones, and if inputs are given, all the ones that aren't among them
+func (s *server) unspendableOutpoints(inputs []*externalapi.DomainOutpoint) (map[externalapi.DomainOutpoint]struct{}, error) {
+	inputSet := make(map[externalapi.DomainOutpoint]struct{}, len(inputs))
+	for _, input := range inputs {
+		if _, ok := s.utxosByOutpoint[*input]; !ok {
+			return nil, errors.Errorf("%s:%d is not a UTXO of the wallet", input.TransactionID, input.Index)
+		}
+		if s.utxoLocks.isLocked(input) {
+			return nil, errors.Errorf("%s:%d is locked, and must be unlocked before it's spent",
+				input.TransactionID, input.Index)
+		}
+		inputSet[*input] = struct{}{}
+	}
+
+	unspendableOutpoints := make(map[externalapi.DomainOutpoint]struct{})
+	for _, utxo := range s.utxosSortedByAmount {
+		_, isInput := inputSet[*utxo.Outpoint]
+		if s.utxoLocks.isLocked(utxo.Outpoint) || (len(inputs) > 0 && !isInput) {
+			unspendableOutpoints[*utxo.Outpoint] = struct{}{}
+		}
+	}
+	return unspendableOutpoints, nil
+}
+
+// createUnsignedTransactionsForBatch creates the unsigned transactions that pay the given
+// payments, which are few enough to fit into a single transaction, along with the transactions
+// that compound its inputs if there are too many of them
+func (s *server) createUnsignedTransactionsForBatch(payments []*libkaspawallet.Payment, isSendAll bool,
+	fromAddresses []*walletAddress, changeAddress util.Address, changeWalletAddress *walletAddress,
+	feeCalculator *feeCalculator, selectedOutpoints map[externalapi.DomainOutpoint]struct{}) ([][]byte, error) {
+
+	spendAmount := uint64(0)
+	for _, payment := range payments {
+		if spendAmount > math.MaxUint64-payment.Amount {
+			return nil, errors.Errorf("the total amount of the payments overflows")
+		}
+		spendAmount += payment.Amount
+	}
+
+	selectedUTXOs, spendValue, changeSompi, err := s.selectUTXOs(spendAmount, isSendAll,
+		feeCalculator.withPaymentCount(len(payments)), fromAddresses, selectedOutpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(selectedUTXOs) == 0 {
+		return nil, errors.Errorf("couldn't find funds to spend")
+	}
+
+	if isSendAll {
+		payments = []*libkaspawallet.Payment{{
+			Address: payments[0].Address,
+			Amount:  spendValue,
+		}}
+	}
+	outputs := make([]*libkaspawallet.Payment, len(payments), len(payments)+1)
+	copy(outputs, payments)
 	if changeSompi > 0 {
-		payments = append(payments, &libkaspawallet.Payment{
+		outputs = append(outputs, &libkaspawallet.Payment{
 			Address: changeAddress,
 			Amount:  changeSompi,
 		})
 	}
 	unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
 		s.keysFile.MinimumSignatures,
-		payments, selectedUTXOs)
+		outputs, selectedUTXOs)
 	if err != nil {
 		return nil, err
 	}
 
-	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
+	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, payments, changeAddress,
+		changeWalletAddress, feeCalculator, selectedOutpoints)
 	if err != nil {
 		return nil, err
 	}
 	return unsignedTransactions, nil
 }
 
-func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uint64, fromAddresses []*walletAddress) (
+// selectUTXOs selects UTXOs to pay spendAmount and the fee with, skipping the ones in selectedOutpoints.
+// The selected UTXOs are added to selectedOutpoints
+func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feeCalculator *feeCalculator, fromAddresses []*walletAddress,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{}) (
 	selectedUTXOs []*libkaspawallet.UTXO, totalReceived uint64, changeSompi uint64, err error) {
 
 	selectedUTXOs = []*libkaspawallet.UTXO{}
@@ -112,6 +235,10 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 			continue
 		}
 
+		if _, ok := selectedOutpoints[*utxo.Outpoint]; ok {
+			continue
+		}
+
 		if broadcastTime, ok := s.usedOutpoints[*utxo.Outpoint]; ok {
 			if time.Since(broadcastTime) > time.Minute {
 				delete(s.usedOutpoints, *utxo.Outpoint)
@@ -128,14 +255,14 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 
 		totalValue += utxo.UTXOEntry.Amount()
 
-		fee := feePerInput * uint64(len(selectedUTXOs))
+		fee := feeCalculator.fee(len(selectedUTXOs))
 		totalSpend := spendAmount + fee
 		if !isSendAll && totalValue >= totalSpend {
 			break
 		}
 	}
 
-	fee := feePerInput * uint64(len(selectedUTXOs))
+	fee := feeCalculator.fee(len(selectedUTXOs))
 	var totalSpend uint64
 	if isSendAll {
 		totalSpend = totalValue
@@ -149,5 +276,9 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 			float64(totalSpend)/constants.SompiPerKaspa, float64(totalValue)/constants.SompiPerKaspa)
 	}
 
+	for _, utxo := range selectedUTXOs {
+		selectedOutpoints[*utxo.Outpoint] = struct{}{}
+	}
+
 	return selectedUTXOs, totalReceived, totalValue - totalSpend, nil
 }
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
+	"github.com/shatll-s/nexelliad/domain/dagconfig"
+	"github.com/shatll-s/nexelliad/util"
+)
+
+func TestDecodePaymentsAmounts(t *testing.T) {
+	serverInstance := &server{params: &dagconfig.MainnetParams}
+	address, err := util.NewAddressPublicKey(make([]byte, 32), dagconfig.MainnetParams.Prefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %s", err)
+	}
+
+	tests := []struct {
+		name          string
+		amounts       []uint64
+		expectedError bool
+	}{
+		{name: "max sompi", amounts: []uint64{constants.MaxSompi}},
+		{name: "above max sompi", amounts: []uint64{constants.MaxSompi + 1}, expectedError: true},
+		{name: "max uint64", amounts: []uint64{math.MaxUint64}, expectedError: true},
+		{name: "total above max sompi", amounts: []uint64{constants.MaxSompi, 1}, expectedError: true},
+		{name: "total wrapping around", amounts: []uint64{math.MaxUint64, 2}, expectedError: true},
+	}
+	for _, test := range tests {
+		requestPayments := make([]*pb.Payment, len(test.amounts))
+		for i, amount := range test.amounts {
+			requestPayments[i] = &pb.Payment{Address: address.String(), Amount: amount}
+		}
+		_, err := serverInstance.decodePayments("", 0, requestPayments, false)
+		if test.expectedError != (err != nil) {
+			t.Errorf("%s: expected an error: %t, got: %v", test.name, test.expectedError, err)
+		}
+	}
+}
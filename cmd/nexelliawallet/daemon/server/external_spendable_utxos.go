@@ -52,10 +52,14 @@ func (s *server) selectExternalSpendableUTXOs(externalUTXOs *appmessage.GetUTXOs
 	return selectedExternalUtxos, nil
 }
 
+// dustCoinbaseAmount is the amount under which a coinbase UTXO
+// is considered not worth the fee of spending it
+const dustCoinbaseAmount = 10000
+
 func isExternalUTXOSpendable(entry *appmessage.UTXOsByAddressesEntry, virtualDAAScore uint64, coinbaseMaturity uint64) bool {
 	if !entry.UTXOEntry.IsCoinbase {
 		return true
-	} else if entry.UTXOEntry.Amount <= feePerInput {
+	} else if entry.UTXOEntry.Amount <= dustCoinbaseAmount {
 		return false
 	}
 	return entry.UTXOEntry.BlockDAAScore+coinbaseMaturity < virtualDAAScore
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"math"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet/serialization"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/utxo"
+	"github.com/pkg/errors"
+)
+
+// The fee priorities a transaction can be created with. Each one
+// corresponds to a bucket of the node's GetFeeEstimate response
+const (
+	feePriorityHigh   = "priority"
+	feePriorityNormal = "normal"
+	feePriorityLow    = "low"
+)
+
+// feeCalculator calculates the fees of the transactions the wallet creates.
+// All wallet inputs have the same mass, so the mass of a transaction is
+// estimated from the number of its inputs. Every transaction is assumed to
+// have its payment outputs and a change output, which slightly overestimates
+// the fee of transactions that have no change.
+type feeCalculator struct {
+	feeRate           float64
+	massWithoutInputs uint64
+	massPerInput      uint64
+	massPerOutput     uint64
+}
+
+// fee returns the fee of a transaction with the given number of inputs
+func (fc *feeCalculator) fee(inputCount int) uint64 {
+	mass := fc.massWithoutInputs + uint64(inputCount)*fc.massPerInput
+	return uint64(math.Ceil(fc.feeRate * float64(mass)))
+}
+
+// feePerInput returns how much adding a single input adds to a transaction's fee
+func (fc *feeCalculator) feePerInput() uint64 {
+	return uint64(math.Ceil(fc.feeRate * float64(fc.massPerInput)))
+}
+
+// withPaymentCount returns a copy of fc that calculates the fees of
+// transactions with the given number of payment outputs
+func (fc *feeCalculator) withPaymentCount(paymentCount int) *feeCalculator {
+	paymentsFeeCalculator := *fc
+	if paymentCount > 1 {
+		paymentsFeeCalculator.massWithoutInputs += uint64(paymentCount-1) * fc.massPerOutput
+	}
+	return &paymentsFeeCalculator
+}
+
+// newFeeCalculator creates a feeCalculator with the fee rate the node
+// currently estimates for the given priority
+func (s *server) newFeeCalculator(feePriority string) (*feeCalculator, error) {
+	feeEstimate, err := s.rpcClient.GetFeeEstimate()
+	if err != nil {
+		return nil, err
+	}
+
+	var bucket *appmessage.RPCFeeEstimateBucket
+	switch feePriority {
+	case feePriorityHigh:
+		bucket = feeEstimate.PriorityBucket
+	case feePriorityNormal, "":
+		bucket = feeEstimate.NormalBucket
+	case feePriorityLow:
+		bucket = feeEstimate.LowBucket
+	default:
+		return nil, errors.Errorf("unknown fee priority %s. Valid priorities are %s, %s and %s",
+			feePriority, feePriorityHigh, feePriorityNormal, feePriorityLow)
+	}
+	if bucket == nil {
+		return nil, errors.Errorf("the node did not return a %s fee estimate", feePriority)
+	}
+
+	massWithoutInputs, massPerInput, massPerOutput, err := s.estimateTransactionMasses()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Using a fee rate of %f sompi per gram (expected to be mined within %.0f seconds)",
+		bucket.FeeRate, bucket.EstimatedSeconds)
+	return &feeCalculator{
+		feeRate:           bucket.FeeRate,
+		massWithoutInputs: massWithoutInputs,
+		massPerInput:      massPerInput,
+		massPerOutput:     massPerOutput,
+	}, nil
+}
+
+// estimateTransactionMasses builds a signed-sized dummy transaction that has a
+// single input and pays to two of the wallet's addresses, and returns its mass
+// without the input, the mass of the input itself and the mass of each output
+func (s *server) estimateTransactionMasses() (massWithoutInputs uint64, massPerInput uint64, massPerOutput uint64,
+	err error) {
+
+	walletAddr := &walletAddress{
+		index:         0,
+		cosignerIndex: s.keysFile.CosignerIndex,
+		keyChain:      libkaspawallet.ExternalKeychain,
+	}
+	path := s.walletAddressPath(walletAddr)
+	address, err := libkaspawallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures,
+		path, s.keysFile.ECDSA)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	dummyUTXO := &libkaspawallet.UTXO{
+		Outpoint:       &externalapi.DomainOutpoint{},
+		UTXOEntry:      utxo.NewUTXOEntry(2, scriptPublicKey, false, 0),
+		DerivationPath: path,
+	}
+	payments := []*libkaspawallet.Payment{
+		{Address: address, Amount: 1},
+		{Address: address, Amount: 1},
+	}
+	dummyTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures, payments, []*libkaspawallet.UTXO{dummyUTXO})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	dummyTransaction, err := serialization.DeserializePartiallySignedTransaction(dummyTransactionBytes)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	mass, err := s.estimateMassAfterSignatures(dummyTransaction)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	transactionWithoutInputs := dummyTransaction.Tx.Clone()
+	transactionWithoutInputs.Inputs = []*externalapi.DomainTransactionInput{}
+	massWithoutInputs = s.txMassCalculator.CalculateTransactionMass(transactionWithoutInputs)
+	transactionWithoutInputs.Outputs = transactionWithoutInputs.Outputs[:1]
+	massPerOutput = massWithoutInputs - s.txMassCalculator.CalculateTransactionMass(transactionWithoutInputs)
+
+	return massWithoutInputs, mass - massWithoutInputs, massPerOutput, nil
+}
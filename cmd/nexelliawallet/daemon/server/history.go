@@ -0,0 +1,284 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+// The statuses of the transactions in the history
+const (
+	transactionStatusPending  = "pending"
+	transactionStatusAccepted = "accepted"
+)
+
+// transactionHistory keeps the transactions that spend from or pay to the wallet's
+// addresses in a JSON file next to the keys file. Transactions the daemon broadcasts
+// are recorded in full. Other transactions are only seen through the UTXOs they
+// add to the wallet's addresses, so only their received outputs are known.
+type transactionHistory struct {
+	path    string
+	records map[string]*transactionRecord
+	// pendingInputs maps the inputs of pending outgoing transactions to these transactions
+	pendingInputs map[externalapi.DomainOutpoint]*transactionRecord
+	isDirty       bool
+}
+
+type transactionRecord struct {
+	TransactionID string `json:"transactionId"`
+	// Timestamp is when the transaction was first observed, in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+	// AcceptingDAAScore is 0 while the transaction is pending
+	AcceptingDAAScore uint64 `json:"acceptingDaaScore"`
+
+	// The following fields are only set for transactions the daemon broadcast
+	IsOutgoing     bool              `json:"isOutgoing"`
+	Inputs         []*recordOutpoint `json:"inputs,omitempty"`
+	SentAmount     uint64            `json:"sentAmount"`
+	Fee            uint64            `json:"fee"`
+	Counterparties []string          `json:"counterparties,omitempty"`
+
+	ReceivedOutputs []*receivedOutput `json:"receivedOutputs,omitempty"`
+
+	// pendingInputs are the inputs of a pending outgoing transaction
+	pendingInputs []externalapi.DomainOutpoint
+}
+
+type recordOutpoint struct {
+	TransactionID string `json:"transactionId"`
+	Index         uint32 `json:"index"`
+}
+
+type receivedOutput struct {
+	Index   uint32 `json:"index"`
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+}
+
+// historyFilePath returns the path of the history file of the given keys file, which
+// is the keys file path with its extension replaced by .history.json
+func historyFilePath(keysFilePath string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + ".history.json"
+}
+
+func newTransactionHistory(path string) *transactionHistory {
+	return &transactionHistory{
+		path:          path,
+		records:       make(map[string]*transactionRecord),
+		pendingInputs: make(map[externalapi.DomainOutpoint]*transactionRecord),
+	}
+}
+
+// readTransactionHistory reads the history in the given file, or returns
+// an empty history if the file doesn't exist yet
+func readTransactionHistory(path string) (*transactionHistory, error) {
+	history := newTransactionHistory(path)
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []*transactionRecord
+	err = json.NewDecoder(file).Decode(&records)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding the transaction history file %s", path)
+	}
+
+	for _, record := range records {
+		history.records[record.TransactionID] = record
+		if record.IsOutgoing && record.AcceptingDAAScore == 0 {
+			err := history.addPendingInputs(record)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return history, nil
+}
+
+// save writes the history to its file, if it changed since it was last saved
+func (th *transactionHistory) save() error {
+	if !th.isDirty {
+		return nil
+	}
+
+	err := writeJSONFile(th.path, th.sortedRecords())
+	if err != nil {
+		return err
+	}
+
+	th.isDirty = false
+	return nil
+}
+
+// sortedRecords returns the records, the most recent first
+func (th *transactionHistory) sortedRecords() []*transactionRecord {
+	records := make([]*transactionRecord, 0, len(th.records))
+	for _, record := range th.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Timestamp != records[j].Timestamp {
+			return records[i].Timestamp > records[j].Timestamp
+		}
+		return records[i].TransactionID < records[j].TransactionID
+	})
+	return records
+}
+
+func (th *transactionHistory) record(transactionID string) *transactionRecord {
+	record, ok := th.records[transactionID]
+	if !ok {
+		record = &transactionRecord{
+			TransactionID: transactionID,
+			Timestamp:     time.Now().UnixMilli(),
+		}
+		th.records[transactionID] = record
+	}
+	return record
+}
+
+func (th *transactionHistory) addPendingInputs(record *transactionRecord) error {
+	record.pendingInputs = make([]externalapi.DomainOutpoint, len(record.Inputs))
+	for i, input := range record.Inputs {
+		transactionID, err := transactionid.FromString(input.TransactionID)
+		if err != nil {
+			return err
+		}
+		record.pendingInputs[i] = externalapi.DomainOutpoint{TransactionID: *transactionID, Index: input.Index}
+		th.pendingInputs[record.pendingInputs[i]] = record
+	}
+	return nil
+}
+
+// addOutgoingTransaction records a transaction the daemon broadcast. Its outputs that pay to
+// the wallet are recorded once they are added to the UTXO set, like those of any other transaction
+func (th *transactionHistory) addOutgoingTransaction(transactionID string, inputs []*externalapi.DomainOutpoint,
+	sentAmount uint64, fee uint64, counterparties []string) error {
+
+	record := th.record(transactionID)
+	if record.IsOutgoing {
+		// The same transaction was broadcast again
+		return nil
+	}
+
+	record.IsOutgoing = true
+	record.Inputs = make([]*recordOutpoint, len(inputs))
+	for i, input := range inputs {
+		record.Inputs[i] = &recordOutpoint{
+			TransactionID: input.TransactionID.String(),
+			Index:         input.Index,
+		}
+	}
+	record.SentAmount = sentAmount
+	record.Fee = fee
+	record.Counterparties = counterparties
+	th.isDirty = true
+
+	if record.AcceptingDAAScore != 0 {
+		return nil
+	}
+	return th.addPendingInputs(record)
+}
+
+// addReceivedOutput records an output that was added to the UTXO set of the wallet at the given DAA score.
+// Outputs that were already recorded are ignored
+func (th *transactionHistory) addReceivedOutput(outpoint *externalapi.DomainOutpoint, address string, amount uint64,
+	blockDAAScore uint64) {
+
+	record := th.record(outpoint.TransactionID.String())
+	for _, output := range record.ReceivedOutputs {
+		if output.Index == outpoint.Index {
+			return
+		}
+	}
+
+	record.ReceivedOutputs = append(record.ReceivedOutputs, &receivedOutput{
+		Index:   outpoint.Index,
+		Address: address,
+		Amount:  amount,
+	})
+	th.setAccepted(record, blockDAAScore)
+	th.isDirty = true
+}
+
+// pendingTransactionSpending returns the pending outgoing transaction that spends the given outpoint, if any.
+// Since outpoints are removed from the UTXO set once their spending transactions are
+// accepted, this transaction is now accepted
+func (th *transactionHistory) pendingTransactionSpending(outpoint *externalapi.DomainOutpoint) *transactionRecord {
+	return th.pendingInputs[*outpoint]
+}
+
+// hasReceivedOutput returns whether the given outpoint was recorded as an output received by the wallet
+func (th *transactionHistory) hasReceivedOutput(outpoint *externalapi.DomainOutpoint) bool {
+	record, ok := th.records[outpoint.TransactionID.String()]
+	if !ok {
+		return false
+	}
+	for _, output := range record.ReceivedOutputs {
+		if output.Index == outpoint.Index {
+			return true
+		}
+	}
+	return false
+}
+
+// setAccepted marks the given record as accepted at the given DAA score, unless it's already accepted
+func (th *transactionHistory) setAccepted(record *transactionRecord, acceptingDAAScore uint64) {
+	if record.AcceptingDAAScore != 0 {
+		return
+	}
+
+	record.AcceptingDAAScore = acceptingDAAScore
+	for _, outpoint := range record.pendingInputs {
+		if th.pendingInputs[outpoint] == record {
+			delete(th.pendingInputs, outpoint)
+		}
+	}
+	record.pendingInputs = nil
+	th.isDirty = true
+}
+
+func (record *transactionRecord) status() string {
+	if record.AcceptingDAAScore == 0 {
+		return transactionStatusPending
+	}
+	return transactionStatusAccepted
+}
+
+func (record *transactionRecord) receivedAmount() uint64 {
+	receivedAmount := uint64(0)
+	for _, output := range record.ReceivedOutputs {
+		receivedAmount += output.Amount
+	}
+	return receivedAmount
+}
+
+// hasAddress returns whether the given address is one of the record's counterparties,
+// or one of the wallet addresses it paid to
+func (record *transactionRecord) hasAddress(address string) bool {
+	for _, counterparty := range record.Counterparties {
+		if counterparty == address {
+			return true
+		}
+	}
+	for _, output := range record.ReceivedOutputs {
+		if output.Address == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+func TestTransactionHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.history.json")
+	history, err := readTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("readTransactionHistory: %+v", err)
+	}
+
+	fundingTransactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	fundingOutpoint := &externalapi.DomainOutpoint{TransactionID: *fundingTransactionID, Index: 0}
+	history.addReceivedOutput(fundingOutpoint, "nexellia:wallet", 100, 10)
+
+	outgoingTransactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{2})
+	err = history.addOutgoingTransaction(outgoingTransactionID.String(), []*externalapi.DomainOutpoint{fundingOutpoint},
+		60, 1, []string{"nexellia:counterparty"})
+	if err != nil {
+		t.Fatalf("addOutgoingTransaction: %+v", err)
+	}
+
+	err = history.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+	history, err = readTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("readTransactionHistory: %+v", err)
+	}
+
+	fundingRecord := history.records[fundingOutpoint.TransactionID.String()]
+	if fundingRecord == nil || fundingRecord.status() != transactionStatusAccepted || fundingRecord.receivedAmount() != 100 {
+		t.Fatalf("The funding transaction wasn't recorded as an accepted transaction that received 100 sompi")
+	}
+
+	outgoingRecord := history.pendingTransactionSpending(fundingOutpoint)
+	if outgoingRecord == nil || outgoingRecord.TransactionID != outgoingTransactionID.String() {
+		t.Fatalf("The outgoing transaction wasn't found by the outpoint it spends after reading the history")
+	}
+	if outgoingRecord.status() != transactionStatusPending || !outgoingRecord.hasAddress("nexellia:counterparty") {
+		t.Fatalf("The outgoing transaction should be pending, with its counterparty")
+	}
+
+	// The change output gives the outgoing transaction its accepting DAA score
+	changeOutpoint := &externalapi.DomainOutpoint{TransactionID: *outgoingTransactionID, Index: 1}
+	history.addReceivedOutput(changeOutpoint, "nexellia:change", 39, 20)
+	history.addReceivedOutput(changeOutpoint, "nexellia:change", 39, 20)
+	if outgoingRecord.status() != transactionStatusAccepted || outgoingRecord.AcceptingDAAScore != 20 {
+		t.Fatalf("The outgoing transaction should have been accepted at DAA score 20")
+	}
+	if outgoingRecord.receivedAmount() != 39 {
+		t.Fatalf("Expected the outgoing transaction to receive 39 sompi of change, but it received %d",
+			outgoingRecord.receivedAmount())
+	}
+	if history.pendingTransactionSpending(fundingOutpoint) != nil {
+		t.Fatalf("An accepted transaction was still found as pending")
+	}
+}
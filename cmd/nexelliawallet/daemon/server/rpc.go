@@ -5,15 +5,18 @@ import (
 
 	"github.com/shatll-s/nexelliad/domain/dagconfig"
 	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient"
+	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient/grpcclient"
 )
 
-func connectToRPC(params *dagconfig.Params, rpcServer string, timeout uint32) (*rpcclient.RPCClient, error) {
+func connectToRPC(params *dagconfig.Params, rpcServer string, rpcConnectOptions *grpcclient.ConnectOptions,
+	timeout uint32) (*rpcclient.RPCClient, error) {
+
 	rpcAddress, err := params.NormalizeRPCServerAddress(rpcServer)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	rpcClient, err := rpcclient.NewRPCClientWithOptions(rpcAddress, rpcConnectOptions)
 	if err != nil {
 		return nil, err
 	}
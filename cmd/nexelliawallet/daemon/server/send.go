@@ -10,8 +10,22 @@ func (s *server) Send(_ context.Context, request *pb.SendRequest) (*pb.SendRespo
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+	// Checked before the transactions are created, so that their change address isn't used up
+	if s.keysFile.IsWatchOnly() {
+		return nil, errWatchOnly
+	}
+
+	payments, err := s.decodePayments(request.ToAddress, request.Amount, request.Payments, request.IsSendAll)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := decodeOutpoints(request.Utxos)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTransactions, err := s.createUnsignedTransactions(payments, request.IsSendAll,
+		request.From, inputs, request.UseExistingChangeAddress, request.FeePriority)
 
 	if err != nil {
 		return nil, err
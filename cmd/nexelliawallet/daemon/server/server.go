@@ -17,6 +17,7 @@ import (
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/keys"
 	"github.com/shatll-s/nexelliad/domain/dagconfig"
 	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient"
+	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient/grpcclient"
 	"github.com/shatll-s/nexelliad/infrastructure/os/signal"
 	"github.com/shatll-s/nexelliad/util/panics"
 	"github.com/pkg/errors"
@@ -39,6 +40,20 @@ type server struct {
 	txMassCalculator    *txmass.Calculator
 	usedOutpoints       map[externalapi.DomainOutpoint]time.Time
 
+	// utxosByOutpoint indexes utxosSortedByAmount. It's nil until the UTXO set is first loaded
+	utxosByOutpoint                            map[externalapi.DomainOutpoint]*walletUTXO
+	isRegisteredForUTXOsChanged                bool
+	isRegisteredForPruningPointUTXOSetOverride bool
+	reconnectedChan                            chan struct{}
+	fullRefreshChan                            chan struct{}
+
+	history   *transactionHistory
+	utxoLocks *utxoLocks
+	// walletAddresses caches the addresses of the wallet up to nextWalletAddressIndex,
+	// whether they were used or not
+	walletAddresses        walletAddressSet
+	nextWalletAddressIndex uint32
+
 	isLogFinalProgressLineShown bool
 	maxUsedAddressesForLog      uint32
 	maxProcessedAddressesForLog uint32
@@ -49,7 +64,9 @@ type server struct {
 const MaxDaemonSendMsgSize = 100_000_000
 
 // Start starts the nexelliawalletd server
-func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath string, profile string, timeout uint32) error {
+func Start(params *dagconfig.Params, listen, rpcServer string, rpcConnectOptions *grpcclient.ConnectOptions, keysFilePath string, profile string,
+	timeout uint32) error {
+
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	defer panics.HandlePanic(log, "MAIN", nil)
@@ -66,7 +83,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	log.Infof("Listening to TCP on %s", listen)
 
 	log.Infof("Connecting to a node at %s...", rpcServer)
-	rpcClient, err := connectToRPC(params, rpcServer, timeout)
+	rpcClient, err := connectToRPC(params, rpcServer, rpcConnectOptions, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error connecting to RPC server %s", rpcServer))
 	}
@@ -81,6 +98,19 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	if err != nil {
 		return err
 	}
+	if keysFile.IsWatchOnly() {
+		log.Infof("The keys file is watch-only. Transactions will have to be signed elsewhere")
+	}
+
+	history, err := readTransactionHistory(historyFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+
+	utxoLocks, err := readUTXOLocks(utxoLocksFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
 
 	serverInstance := &server{
 		rpcClient:                   rpcClient,
@@ -92,6 +122,11 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		addressSet:                  make(walletAddressSet),
 		txMassCalculator:            txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		reconnectedChan:             make(chan struct{}, 1),
+		fullRefreshChan:             make(chan struct{}, 1),
+		history:                     history,
+		utxoLocks:                   utxoLocks,
+		walletAddresses:             make(walletAddressSet),
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
@@ -134,6 +169,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		}
 	}
 
+	serverInstance.saveHistoryWithLock()
 	return nil
 }
 
@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/keys"
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
+	"github.com/pkg/errors"
 
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
 )
@@ -19,7 +21,16 @@ func (s *server) Sign(_ context.Context, request *pb.SignRequest) (*pb.SignRespo
 	return &pb.SignResponse{SignedTransactions: signedTransactions}, nil
 }
 
+// errWatchOnly is returned by the RPCs that sign transactions when the daemon runs with a watch-only keys file
+var errWatchOnly = errors.Wrap(keys.ErrWatchOnly, "the wallet daemon cannot sign transactions. "+
+	"Create them with 'create-unsigned-transaction', sign them on a machine with the private keys, "+
+	"and then use 'broadcast'")
+
 func (s *server) signTransactions(unsignedTransactions [][]byte, password string) ([][]byte, error) {
+	if s.keysFile.IsWatchOnly() {
+		return nil, errWatchOnly
+	}
+
 	mnemonics, err := s.keysFile.DecryptMnemonics(password)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/keys"
+	"github.com/pkg/errors"
+)
+
+func TestWatchOnlySigning(t *testing.T) {
+	serverInstance := &server{
+		keysFile: &keys.File{
+			Version:            keys.LastVersion,
+			ExtendedPublicKeys: []string{"kpub"},
+			MinimumSignatures:  1,
+		},
+	}
+
+	_, err := serverInstance.Sign(context.Background(), &pb.SignRequest{UnsignedTransactions: [][]byte{{1}}})
+	if !errors.Is(err, keys.ErrWatchOnly) {
+		t.Fatalf("Sign: expected ErrWatchOnly but got %v", err)
+	}
+
+	// The daemon refuses to send before it creates any transaction
+	_, err = serverInstance.Send(context.Background(), &pb.SendRequest{ToAddress: "nexellia:test", Amount: 1})
+	if !errors.Is(err, keys.ErrWatchOnly) {
+		t.Fatalf("Send: expected ErrWatchOnly but got %v", err)
+	}
+}
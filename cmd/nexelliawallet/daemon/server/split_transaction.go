@@ -18,16 +18,18 @@ import (
 // transaction.
 // If it is - the transaction is split into multiple transactions, each with a portion of the inputs and a single output
 // into a change address.
-// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into a single output
-// paying to the original transaction's payee.
-func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([][]byte, error) {
+// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into the outputs
+// paying to the original transaction's payees.
+func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, payments []*libkaspawallet.Payment,
+	changeAddress util.Address, changeWalletAddress *walletAddress, feeCalculator *feeCalculator,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{}) ([][]byte, error) {
 	transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, toAddress, changeAddress, changeWalletAddress)
+	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, payments, changeAddress, changeWalletAddress,
+		feeCalculator, selectedOutpoints)
 	if err != nil {
 		return nil, err
 	}
@@ -41,24 +43,49 @@ func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress
 	return splitTransactionsBytes, nil
 }
 
+// splitPayments splits the given payments into batches, each small enough for the outputs of its
+// transaction to take at most half of the mass of a standard transaction. The other half is left for
+// inputs, so that splitting and merging a batch's transaction can always bring it under the mass limit
+func splitPayments(payments []*libkaspawallet.Payment, feeCalculator *feeCalculator) [][]*libkaspawallet.Payment {
+	const maxMassForOutputs = mempool.MaximumStandardTransactionMass / 2
+
+	// massWithoutInputs already accounts for a single payment and change
+	maxPaymentsPerBatch := 1
+	if feeCalculator.massPerOutput > 0 && feeCalculator.massWithoutInputs < maxMassForOutputs {
+		maxPaymentsPerBatch += int((maxMassForOutputs - feeCalculator.massWithoutInputs) / feeCalculator.massPerOutput)
+	}
+
+	batches := make([][]*libkaspawallet.Payment, 0, (len(payments)+maxPaymentsPerBatch-1)/maxPaymentsPerBatch)
+	for len(payments) > maxPaymentsPerBatch {
+		batches = append(batches, payments[:maxPaymentsPerBatch])
+		payments = payments[maxPaymentsPerBatch:]
+	}
+	return append(batches, payments)
+}
+
 func (s *server) mergeTransaction(
 	splitTransactions []*serialization.PartiallySignedTransaction,
 	originalTransaction *serialization.PartiallySignedTransaction,
-	toAddress util.Address,
+	payments []*libkaspawallet.Payment,
 	changeAddress util.Address,
 	changeWalletAddress *walletAddress,
+	feeCalculator *feeCalculator,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{},
 ) (*serialization.PartiallySignedTransaction, error) {
 	numOutputs := len(originalTransaction.Tx.Outputs)
-	if numOutputs > 2 || numOutputs == 0 {
-		// This is a sanity check to make sure originalTransaction has either 1 or 2 outputs:
-		// 1. For the payment itself
+	if numOutputs != len(payments) && numOutputs != len(payments)+1 {
+		// This is a sanity check to make sure originalTransaction has the following outputs:
+		// 1. For the payments themselves
 		// 2. (optional) for change
-		return nil, errors.Errorf("original transaction has %d outputs, while 1 or 2 are expected",
-			len(originalTransaction.Tx.Outputs))
+		return nil, errors.Errorf("original transaction has %d outputs, while %d or %d are expected",
+			len(originalTransaction.Tx.Outputs), len(payments), len(payments)+1)
 	}
 
 	totalValue := uint64(0)
-	sentValue := originalTransaction.Tx.Outputs[0].Value
+	sentValue := uint64(0)
+	for _, payment := range payments {
+		sentValue += payment.Amount
+	}
 	utxos := make([]*libkaspawallet.UTXO, len(splitTransactions))
 	for i, splitTransaction := range splitTransactions {
 		output := splitTransaction.Tx.Outputs[0]
@@ -71,13 +98,14 @@ func (s *server) mergeTransaction(
 			DerivationPath: s.walletAddressPath(changeWalletAddress),
 		}
 		totalValue += output.Value
-		totalValue -= feePerInput
 	}
+	totalValue -= feeCalculator.withPaymentCount(len(payments)).fee(len(utxos))
 
 	if totalValue < sentValue {
 		// sometimes the fees from compound transactions make the total output higher than what's available from selected
 		// utxos, in such cases - find one more UTXO and use it.
-		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue)
+		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue, feeCalculator,
+			selectedOutpoints)
 		if err != nil {
 			return nil, err
 		}
@@ -85,19 +113,17 @@ func (s *server) mergeTransaction(
 		totalValue += totalValueAdded
 	}
 
-	payments := []*libkaspawallet.Payment{{
-		Address: toAddress,
-		Amount:  sentValue,
-	}}
+	outputs := make([]*libkaspawallet.Payment, len(payments), len(payments)+1)
+	copy(outputs, payments)
 	if totalValue > sentValue {
-		payments = append(payments, &libkaspawallet.Payment{
+		outputs = append(outputs, &libkaspawallet.Payment{
 			Address: changeAddress,
 			Amount:  totalValue - sentValue,
 		})
 	}
 
 	mergeTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures, payments, utxos)
+		s.keysFile.MinimumSignatures, outputs, utxos)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +131,10 @@ func (s *server) mergeTransaction(
 	return serialization.DeserializePartiallySignedTransaction(mergeTransactionBytes)
 }
 
-func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([]*serialization.PartiallySignedTransaction, error) {
+func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction,
+	payments []*libkaspawallet.Payment, changeAddress util.Address, changeWalletAddress *walletAddress,
+	feeCalculator *feeCalculator, selectedOutpoints map[externalapi.DomainOutpoint]struct{},
+) ([]*serialization.PartiallySignedTransaction, error) {
 
 	transactionMass, err := s.estimateMassAfterSignatures(transaction)
 	if err != nil {
@@ -117,7 +145,8 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 		return []*serialization.PartiallySignedTransaction{transaction}, nil
 	}
 
-	splitCount, inputCountPerSplit, err := s.splitAndInputPerSplitCounts(transaction, transactionMass, changeAddress)
+	splitCount, inputCountPerSplit, err := s.splitAndInputPerSplitCounts(transaction, transactionMass, changeAddress,
+		feeCalculator)
 	if err != nil {
 		return nil, err
 	}
@@ -127,19 +156,21 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 		startIndex := i * inputCountPerSplit
 		endIndex := startIndex + inputCountPerSplit
 		var err error
-		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex)
+		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex, feeCalculator)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(splitTransactions) > 1 {
-		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, toAddress, changeAddress, changeWalletAddress)
+		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, payments, changeAddress,
+			changeWalletAddress, feeCalculator, selectedOutpoints)
 		if err != nil {
 			return nil, err
 		}
 		// Recursion will be 2-3 iterations deep even in the rarest` cases, so considered safe..
-		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, toAddress, changeAddress, changeWalletAddress)
+		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, payments, changeAddress,
+			changeWalletAddress, feeCalculator, selectedOutpoints)
 		if err != nil {
 			return nil, err
 		}
@@ -152,7 +183,7 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 
 // splitAndInputPerSplitCounts calculates the number of splits to create, and the number of inputs to assign per split.
 func (s *server) splitAndInputPerSplitCounts(transaction *serialization.PartiallySignedTransaction, transactionMass uint64,
-	changeAddress util.Address) (splitCount, inputsPerSplitCount int, err error) {
+	changeAddress util.Address, feeCalculator *feeCalculator) (splitCount, inputsPerSplitCount int, err error) {
 
 	// Create a dummy transaction which is a clone of the original transaction, but without inputs,
 	// to calculate how much mass do all the inputs have
@@ -172,7 +203,7 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 
 	// Create another dummy transaction, this time one similar to the split transactions we wish to generate,
 	// but with 0 inputs, to calculate how much mass for inputs do we have available in the split transactions
-	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0)
+	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0, feeCalculator)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -190,7 +221,8 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 }
 
 func (s *server) createSplitTransaction(transaction *serialization.PartiallySignedTransaction,
-	changeAddress util.Address, startIndex int, endIndex int) (*serialization.PartiallySignedTransaction, error) {
+	changeAddress util.Address, startIndex int, endIndex int, feeCalculator *feeCalculator,
+) (*serialization.PartiallySignedTransaction, error) {
 
 	selectedUTXOs := make([]*libkaspawallet.UTXO, 0, endIndex-startIndex)
 	totalSompi := uint64(0)
@@ -206,7 +238,9 @@ func (s *server) createSplitTransaction(transaction *serialization.PartiallySign
 		})
 
 		totalSompi += selectedUTXOs[i-startIndex].UTXOEntry.Amount()
-		totalSompi -= feePerInput
+	}
+	if len(selectedUTXOs) > 0 {
+		totalSompi -= feeCalculator.fee(len(selectedUTXOs))
 	}
 	unsignedTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
 		s.keysFile.MinimumSignatures,
@@ -248,7 +282,10 @@ func (s *server) estimateMassAfterSignatures(transaction *serialization.Partiall
 	return s.txMassCalculator.CalculateTransactionMass(transactionWithSignatures), nil
 }
 
-func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawallet.UTXO, requiredAmount uint64) (
+// moreUTXOsForMergeTransaction selects UTXOs worth requiredAmount on top of alreadySelectedUTXOs,
+// skipping the ones in selectedOutpoints. The selected UTXOs are added to selectedOutpoints
+func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawallet.UTXO, requiredAmount uint64,
+	feeCalculator *feeCalculator, selectedOutpoints map[externalapi.DomainOutpoint]struct{}) (
 	additionalUTXOs []*libkaspawallet.UTXO, totalValueAdded uint64, err error) {
 
 	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
@@ -264,6 +301,9 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawa
 		if _, ok := alreadySelectedUTXOsMap[*utxo.Outpoint]; ok {
 			continue
 		}
+		if _, ok := selectedOutpoints[*utxo.Outpoint]; ok {
+			continue
+		}
 		if !isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity) {
 			continue
 		}
@@ -271,7 +311,7 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawa
 			Outpoint:       utxo.Outpoint,
 			UTXOEntry:      utxo.UTXOEntry,
 			DerivationPath: s.walletAddressPath(utxo.address)})
-		totalValueAdded += utxo.UTXOEntry.Amount() - feePerInput
+		totalValueAdded += utxo.UTXOEntry.Amount() - feeCalculator.feePerInput()
 		if totalValueAdded >= requiredAmount {
 			break
 		}
@@ -280,5 +320,9 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawa
 		return nil, 0, errors.Errorf("Insufficient funds for merge transaction")
 	}
 
+	for _, additionalUTXO := range additionalUTXOs {
+		selectedOutpoints[*additionalUTXO.Outpoint] = struct{}{}
+	}
+
 	return additionalUTXOs, totalValueAdded, nil
 }
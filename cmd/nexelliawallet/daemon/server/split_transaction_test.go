@@ -150,3 +150,44 @@ func testEstimateMassIncreaseForSignaturesSetUp(t *testing.T, consensusConfig *c
 
 	return unsignedTransaction, mnemonics, params, teardown
 }
+
+func TestSplitPayments(t *testing.T) {
+	payments := make([]*libkaspawallet.Payment, 10)
+	for i := range payments {
+		payments[i] = &libkaspawallet.Payment{Amount: uint64(i + 1)}
+	}
+
+	tests := []struct {
+		name              string
+		massWithoutInputs uint64
+		massPerOutput     uint64
+		expectedSizes     []int
+	}{
+		{name: "all fit", massWithoutInputs: 1000, massPerOutput: 1000, expectedSizes: []int{10}},
+		// (50,000 - 20,000) / 10,000 = 3 payments on top of the one in massWithoutInputs
+		{name: "split", massWithoutInputs: 20_000, massPerOutput: 10_000, expectedSizes: []int{4, 4, 2}},
+		{name: "one per batch", massWithoutInputs: 60_000, massPerOutput: 10_000, expectedSizes: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+	}
+	for _, test := range tests {
+		batches := splitPayments(payments, &feeCalculator{
+			massWithoutInputs: test.massWithoutInputs,
+			massPerOutput:     test.massPerOutput,
+		})
+		if len(batches) != len(test.expectedSizes) {
+			t.Fatalf("%s: expected %d batches but got %d", test.name, len(test.expectedSizes), len(batches))
+		}
+		nextAmount := uint64(1)
+		for i, batch := range batches {
+			if len(batch) != test.expectedSizes[i] {
+				t.Fatalf("%s: expected batch %d to have %d payments but it has %d", test.name, i,
+					test.expectedSizes[i], len(batch))
+			}
+			for _, payment := range batch {
+				if payment.Amount != nextAmount {
+					t.Fatalf("%s: expected the payment with amount %d but got %d", test.name, nextAmount, payment.Amount)
+				}
+				nextAmount++
+			}
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet"
 
 	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/pkg/errors"
 )
 
@@ -27,6 +28,10 @@ func (s *server) sync() error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
+	s.rpcClient.SetOnReconnectedHandler(func() {
+		signalFullRefresh(s.reconnectedChan)
+	})
+
 	err := s.collectRecentAddresses()
 	if err != nil {
 		return err
@@ -37,24 +42,63 @@ func (s *server) sync() error {
 		return err
 	}
 
-	for range ticker.C {
-		err = s.collectFarAddresses()
-		if err != nil {
-			return err
-		}
+	// UTXOs are kept up to date by UTXOsChanged notifications. The ticker only
+	// looks for newly used addresses, and a full refresh is done only when the
+	// notifications might have been missed
+	for {
+		select {
+		case <-ticker.C:
+			err = s.collectFarAddresses()
+			if err != nil {
+				return err
+			}
 
-		err = s.collectRecentAddresses()
-		if err != nil {
-			return err
-		}
+			err = s.collectRecentAddresses()
+			if err != nil {
+				return err
+			}
 
-		err = s.refreshExistingUTXOsWithLock()
-		if err != nil {
-			return err
+			s.saveHistoryWithLock()
+		case <-s.reconnectedChan:
+			log.Infof("Reconnected to the node, refreshing the UTXO set")
+			s.lock.Lock()
+			// Notification registrations don't survive reconnections
+			s.isRegisteredForUTXOsChanged = false
+			s.isRegisteredForPruningPointUTXOSetOverride = false
+			err = s.refreshUTXOs()
+			s.lock.Unlock()
+			if err != nil {
+				return err
+			}
+		case <-s.fullRefreshChan:
+			log.Infof("Refreshing the UTXO set")
+			err = s.refreshExistingUTXOsWithLock()
+			if err != nil {
+				return err
+			}
 		}
 	}
+}
 
-	return nil
+// saveHistoryWithLock saves the changes to the transaction history. Failing to save
+// them isn't fatal, since the next attempt will save them along with newer changes
+func (s *server) saveHistoryWithLock() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.history.save()
+	if err != nil {
+		log.Errorf("Could not save the transaction history: %s", err)
+	}
+}
+
+// signalFullRefresh signals the sync loop to do a full refresh of the UTXO set,
+// unless one is already pending
+func signalFullRefresh(fullRefreshChan chan struct{}) {
+	select {
+	case fullRefreshChan <- struct{}{}:
+	default:
+	}
 }
 
 const (
@@ -158,34 +202,51 @@ func (s *server) collectAddresses(start, end uint32) error {
 		return err
 	}
 
+	// Addresses that are already known to be used are kept up to
+	// date by UTXOsChanged notifications, so there's no need to query them
+	for address := range addressSet {
+		if _, ok := s.addressSet[address]; ok {
+			delete(addressSet, address)
+		}
+	}
+	if len(addressSet) == 0 {
+		return nil
+	}
+
 	getBalancesByAddressesResponse, err := s.rpcClient.GetBalancesByAddresses(addressSet.strings())
 	if err != nil {
 		return err
 	}
 
-	err = s.updateAddressesAndLastUsedIndexes(addressSet, getBalancesByAddressesResponse)
+	newAddresses, err := s.updateAddressesAndLastUsedIndexes(addressSet, getBalancesByAddressesResponse)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.addUTXOsOfNewAddresses(newAddresses)
 }
 
+// updateAddressesAndLastUsedIndexes adds the requested addresses that have a balance to the
+// address set, and returns the ones that weren't in it before
 func (s *server) updateAddressesAndLastUsedIndexes(requestedAddressSet walletAddressSet,
-	getBalancesByAddressesResponse *appmessage.GetBalancesByAddressesResponseMessage) error {
+	getBalancesByAddressesResponse *appmessage.GetBalancesByAddressesResponseMessage) ([]string, error) {
 	lastUsedExternalIndex := s.keysFile.LastUsedExternalIndex()
 	lastUsedInternalIndex := s.keysFile.LastUsedInternalIndex()
 
+	var newAddresses []string
 	for _, entry := range getBalancesByAddressesResponse.Entries {
 		walletAddress, ok := requestedAddressSet[entry.Address]
 		if !ok {
-			return errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
+			return nil, errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
 		}
 
 		if entry.Balance == 0 {
 			continue
 		}
 
+		if _, ok := s.addressSet[entry.Address]; !ok {
+			newAddresses = append(newAddresses, entry.Address)
+		}
 		s.addressSet[entry.Address] = walletAddress
 
 		if walletAddress.keyChain == libkaspawallet.ExternalKeychain {
@@ -202,10 +263,15 @@ func (s *server) updateAddressesAndLastUsedIndexes(requestedAddressSet walletAdd
 
 	err := s.keysFile.SetLastUsedExternalIndex(lastUsedExternalIndex)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return s.keysFile.SetLastUsedInternalIndex(lastUsedInternalIndex)
+	err = s.keysFile.SetLastUsedInternalIndex(lastUsedInternalIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAddresses, nil
 }
 
 func (s *server) refreshExistingUTXOsWithLock() error {
@@ -216,52 +282,261 @@ func (s *server) refreshExistingUTXOsWithLock() error {
 }
 
 // updateUTXOSet clears the current UTXO set, and re-fills it with the given entries
-func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, mempoolEntries []*appmessage.MempoolEntryByAddress) error {
-	utxos := make([]*walletUTXO, 0, len(entries))
+func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry,
+	mempoolSpentOutpoints map[appmessage.RPCOutpoint]struct{}) error {
+
+	s.utxosSortedByAmount = make([]*walletUTXO, 0, len(entries))
+	s.utxosByOutpoint = make(map[externalapi.DomainOutpoint]*walletUTXO, len(entries))
 
-	exclude := make(map[appmessage.RPCOutpoint]struct{})
+	return s.addUTXOs(entries, mempoolSpentOutpoints)
+}
+
+// mempoolSpentOutpoints returns the outpoints spent by the given mempool entries
+func mempoolSpentOutpoints(mempoolEntries []*appmessage.MempoolEntryByAddress) map[appmessage.RPCOutpoint]struct{} {
+	spentOutpoints := make(map[appmessage.RPCOutpoint]struct{})
 	for _, entriesByAddress := range mempoolEntries {
 		for _, entry := range entriesByAddress.Sending {
 			for _, input := range entry.Transaction.Inputs {
-				exclude[*input.PreviousOutpoint] = struct{}{}
+				spentOutpoints[*input.PreviousOutpoint] = struct{}{}
 			}
 		}
 	}
+	return spentOutpoints
+}
+
+// addUTXOs adds the given entries to the UTXO set, except for the ones
+// that are spent in the mempool
+func (s *server) addUTXOs(entries []*appmessage.UTXOsByAddressesEntry,
+	mempoolSpentOutpoints map[appmessage.RPCOutpoint]struct{}) error {
 
 	for _, entry := range entries {
-		if _, ok := exclude[*entry.Outpoint]; ok {
+		if _, ok := mempoolSpentOutpoints[*entry.Outpoint]; ok {
 			continue
 		}
 
-		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+		err := s.addUTXO(entry)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+// addUTXO inserts the given entry into utxosSortedByAmount, keeping it sorted,
+// unless it's already there
+func (s *server) addUTXO(entry *appmessage.UTXOsByAddressesEntry) error {
+	outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.utxosByOutpoint[*outpoint]; ok {
+		return nil
+	}
+
+	utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+	if err != nil {
+		return err
+	}
+
+	address, ok := s.addressSet[entry.Address]
+	if !ok {
+		return errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
+	}
+	utxo := &walletUTXO{
+		Outpoint:  outpoint,
+		UTXOEntry: utxoEntry,
+		address:   address,
+	}
+
+	amount := utxoEntry.Amount()
+	index := sort.Search(len(s.utxosSortedByAmount), func(i int) bool {
+		return s.utxosSortedByAmount[i].UTXOEntry.Amount() < amount
+	})
+	s.utxosSortedByAmount = append(s.utxosSortedByAmount, nil)
+	copy(s.utxosSortedByAmount[index+1:], s.utxosSortedByAmount[index:])
+	s.utxosSortedByAmount[index] = utxo
+	s.utxosByOutpoint[*outpoint] = utxo
+	s.history.addReceivedOutput(outpoint, entry.Address, amount, utxoEntry.BlockDAAScore())
+
+	return nil
+}
+
+// removeUTXO removes the UTXO with the given outpoint from utxosSortedByAmount, if it's there
+func (s *server) removeUTXO(outpoint *externalapi.DomainOutpoint) error {
+	delete(s.usedOutpoints, *outpoint)
+	utxo, ok := s.utxosByOutpoint[*outpoint]
+	if !ok {
+		return nil
+	}
+	delete(s.utxosByOutpoint, *outpoint)
+
+	amount := utxo.UTXOEntry.Amount()
+	index := sort.Search(len(s.utxosSortedByAmount), func(i int) bool {
+		return s.utxosSortedByAmount[i].UTXOEntry.Amount() <= amount
+	})
+	for ; index < len(s.utxosSortedByAmount); index++ {
+		if s.utxosSortedByAmount[index] == utxo {
+			s.utxosSortedByAmount = append(s.utxosSortedByAmount[:index], s.utxosSortedByAmount[index+1:]...)
+			return nil
+		}
+	}
+
+	return errors.Errorf("UTXO %s is missing from the UTXOs sorted by amount", outpoint)
+}
+
+// handleUTXOsChanged applies the given UTXOsChanged notification to the UTXO set.
+// If that fails, the UTXO set can no longer be trusted, so a full refresh is signalled
+func (s *server) handleUTXOsChanged(notification *appmessage.UTXOsChangedNotificationMessage) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	removedOutpoints := make([]*externalapi.DomainOutpoint, len(notification.Removed))
+	for i, entry := range notification.Removed {
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
 		if err != nil {
-			return err
+			log.Warnf("Could not apply a UTXOsChanged notification: %s", err)
+			signalFullRefresh(s.fullRefreshChan)
+			return
+		}
+		err = s.removeUTXO(outpoint)
+		if err != nil {
+			log.Warnf("Could not apply a UTXOsChanged notification: %s", err)
+			signalFullRefresh(s.fullRefreshChan)
+			return
 		}
+		removedOutpoints[i] = outpoint
+	}
+	for _, entry := range notification.Added {
+		err := s.addUTXO(entry)
+		if err != nil {
+			log.Warnf("Could not apply a UTXOsChanged notification: %s", err)
+			signalFullRefresh(s.fullRefreshChan)
+			return
+		}
+	}
 
-		address, ok := s.addressSet[entry.Address]
-		if !ok {
-			return errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
+	// This is done after the added UTXOs are recorded, so that outgoing transactions
+	// that pay change get the DAA score of their change as their accepting DAA score
+	err := s.acceptSpendingTransactions(removedOutpoints)
+	if err != nil {
+		log.Warnf("Could not apply a UTXOsChanged notification: %s", err)
+		signalFullRefresh(s.fullRefreshChan)
+	}
+}
+
+// acceptSpendingTransactions marks the pending outgoing transactions that spend any of the given outpoints,
+// which were removed from the UTXO set, as accepted. The DAA score they were accepted at isn't known,
+// so the current virtual DAA score is used instead
+func (s *server) acceptSpendingTransactions(spentOutpoints []*externalapi.DomainOutpoint) error {
+	var acceptedRecords []*transactionRecord
+	for _, outpoint := range spentOutpoints {
+		record := s.history.pendingTransactionSpending(outpoint)
+		if record != nil {
+			acceptedRecords = append(acceptedRecords, record)
 		}
-		utxos = append(utxos, &walletUTXO{
-			Outpoint:  outpoint,
-			UTXOEntry: utxoEntry,
-			address:   address,
-		})
+	}
+	if len(acceptedRecords) == 0 {
+		return nil
 	}
 
-	sort.Slice(utxos, func(i, j int) bool { return utxos[i].UTXOEntry.Amount() > utxos[j].UTXOEntry.Amount() })
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return err
+	}
+	for _, record := range acceptedRecords {
+		s.history.setAccepted(record, dagInfo.VirtualDAAScore)
+	}
+	return nil
+}
+
+// acceptTransactionsSpentDuringRefresh accepts the pending outgoing transactions that were accepted
+// while the UTXO set wasn't followed, which are the ones that spend UTXOs the wallet used to have and
+// no longer has, and that aren't spent in the mempool
+func (s *server) acceptTransactionsSpentDuringRefresh(mempoolSpentOutpoints map[appmessage.RPCOutpoint]struct{}) error {
+	var spentOutpoints []*externalapi.DomainOutpoint
+	for outpoint := range s.history.pendingInputs {
+		if _, ok := s.utxosByOutpoint[outpoint]; ok {
+			continue
+		}
+		rpcOutpoint := appmessage.RPCOutpoint{TransactionID: outpoint.TransactionID.String(), Index: outpoint.Index}
+		if _, ok := mempoolSpentOutpoints[rpcOutpoint]; ok {
+			continue
+		}
+		if !s.history.hasReceivedOutput(&outpoint) {
+			continue
+		}
+		spentOutpoint := outpoint
+		spentOutpoints = append(spentOutpoints, &spentOutpoint)
+	}
+
+	return s.acceptSpendingTransactions(spentOutpoints)
+}
 
-	s.utxosSortedByAmount = utxos
+// registerForNotifications registers for UTXOsChanged notifications for the given addresses,
+// and for PruningPointUTXOSetOverride notifications, unless already registered
+func (s *server) registerForNotifications(addresses []string) error {
+	if !s.isRegisteredForPruningPointUTXOSetOverride {
+		err := s.rpcClient.RegisterPruningPointUTXOSetNotifications(func() {
+			log.Infof("The node's pruning point UTXO set was overridden")
+			signalFullRefresh(s.fullRefreshChan)
+		})
+		if err != nil {
+			return err
+		}
+		s.isRegisteredForPruningPointUTXOSetOverride = true
+	}
 
+	// An empty address list means all addresses
+	if len(addresses) == 0 {
+		return nil
+	}
+	if s.isRegisteredForUTXOsChanged {
+		return s.rpcClient.AddAddressesToUTXOsChangedNotifications(addresses)
+	}
+	err := s.rpcClient.RegisterForUTXOsChangedNotifications(addresses, s.handleUTXOsChanged)
+	if err != nil {
+		return err
+	}
+	s.isRegisteredForUTXOsChanged = true
 	return nil
 }
 
+// addUTXOsOfNewAddresses registers for UTXOsChanged notifications for the given
+// addresses, which were just added to the address set, and adds their UTXOs
+func (s *server) addUTXOsOfNewAddresses(addresses []string) error {
+	// The initial full refresh will add them
+	if len(addresses) == 0 || s.utxosByOutpoint == nil {
+		return nil
+	}
+
+	// The notifications wait for the lock, so they're applied on top of the
+	// queried UTXOs, no matter which arrive first
+	err := s.registerForNotifications(addresses)
+	if err != nil {
+		return err
+	}
+
+	// See refreshUTXOs for why the mempool is checked first
+	mempoolEntriesByAddresses, err := s.rpcClient.GetMempoolEntriesByAddresses(addresses, true, true)
+	if err != nil {
+		return err
+	}
+
+	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses(addresses)
+	if err != nil {
+		return err
+	}
+
+	return s.addUTXOs(getUTXOsByAddressesResponse.Entries, mempoolSpentOutpoints(mempoolEntriesByAddresses.Entries))
+}
+
 func (s *server) refreshUTXOs() error {
+	err := s.registerForNotifications(s.addressSet.strings())
+	if err != nil {
+		return err
+	}
+
 	// It's important to check the mempool before calling `GetUTXOsByAddresses`:
 	// If we would do it the other way around an output can be spent in the mempool
 	// and not in consensus, and between the calls its spending transaction will be
@@ -277,7 +552,13 @@ func (s *server) refreshUTXOs() error {
 		return err
 	}
 
-	return s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries)
+	spentOutpoints := mempoolSpentOutpoints(mempoolEntriesByAddresses.Entries)
+	err = s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, spentOutpoints)
+	if err != nil {
+		return err
+	}
+
+	return s.acceptTransactionsSpentDuringRefresh(spentOutpoints)
 }
 
 func (s *server) isSynced() bool {
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+func TestHandleUTXOsChanged(t *testing.T) {
+	const address = "nexellia:test"
+	serverInstance := &server{
+		addressSet:      walletAddressSet{address: &walletAddress{}},
+		usedOutpoints:   map[externalapi.DomainOutpoint]time.Time{},
+		utxosByOutpoint: map[externalapi.DomainOutpoint]*walletUTXO{},
+		fullRefreshChan: make(chan struct{}, 1),
+		history:         newTransactionHistory(""),
+	}
+
+	entry := func(index uint32, amount uint64) *appmessage.UTXOsByAddressesEntry {
+		return &appmessage.UTXOsByAddressesEntry{
+			Address: address,
+			Outpoint: &appmessage.RPCOutpoint{
+				TransactionID: "0000000000000000000000000000000000000000000000000000000000000001",
+				Index:         index,
+			},
+			UTXOEntry: &appmessage.RPCUTXOEntry{
+				Amount:          amount,
+				ScriptPublicKey: &appmessage.RPCScriptPublicKey{Script: "51"},
+			},
+		}
+	}
+	assertIndexes := func(testName string, expectedIndexes ...uint32) {
+		if len(serverInstance.utxosSortedByAmount) != len(expectedIndexes) {
+			t.Fatalf("%s: expected %d UTXOs but got %d", testName, len(expectedIndexes),
+				len(serverInstance.utxosSortedByAmount))
+		}
+		if len(serverInstance.utxosByOutpoint) != len(expectedIndexes) {
+			t.Fatalf("%s: expected %d indexed UTXOs but got %d", testName, len(expectedIndexes),
+				len(serverInstance.utxosByOutpoint))
+		}
+		for i, utxo := range serverInstance.utxosSortedByAmount {
+			if utxo.Outpoint.Index != expectedIndexes[i] {
+				t.Fatalf("%s: expected UTXO %d at position %d but got %d", testName,
+					expectedIndexes[i], i, utxo.Outpoint.Index)
+			}
+		}
+	}
+
+	serverInstance.handleUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added: []*appmessage.UTXOsByAddressesEntry{entry(0, 10), entry(1, 30), entry(2, 20), entry(3, 20)},
+	})
+	assertIndexes("added", 1, 2, 3, 0)
+
+	// Applying the same notification twice doesn't duplicate UTXOs
+	serverInstance.handleUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added: []*appmessage.UTXOsByAddressesEntry{entry(1, 30)},
+	})
+	assertIndexes("added twice", 1, 2, 3, 0)
+
+	serverInstance.usedOutpoints[*serverInstance.utxosSortedByAmount[2].Outpoint] = time.Now()
+	serverInstance.handleUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added:   []*appmessage.UTXOsByAddressesEntry{entry(4, 40)},
+		Removed: []*appmessage.UTXOsByAddressesEntry{entry(3, 20), entry(5, 50)},
+	})
+	assertIndexes("removed", 4, 1, 2, 0)
+	if len(serverInstance.usedOutpoints) != 0 {
+		t.Fatalf("Removed UTXOs should no longer be marked as used")
+	}
+
+	select {
+	case <-serverInstance.fullRefreshChan:
+		t.Fatalf("A full refresh was requested even though all notifications were applied")
+	default:
+	}
+
+	serverInstance.handleUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added: []*appmessage.UTXOsByAddressesEntry{{Address: address, Outpoint: &appmessage.RPCOutpoint{TransactionID: "invalid"}}},
+	})
+	select {
+	case <-serverInstance.fullRefreshChan:
+	default:
+		t.Fatalf("A full refresh wasn't requested even though a notification couldn't be applied")
+	}
+}
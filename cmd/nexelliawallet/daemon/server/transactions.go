@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+)
+
+func (s *server) GetTransactions(_ context.Context, request *pb.GetTransactionsRequest) (*pb.GetTransactionsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := []*pb.TransactionHistoryEntry{}
+	for _, record := range s.history.sortedRecords() {
+		if request.Address != "" && !record.hasAddress(request.Address) {
+			continue
+		}
+		transactions = append(transactions, transactionHistoryEntry(record, dagInfo.VirtualDAAScore))
+		if request.Limit != 0 && len(transactions) == int(request.Limit) {
+			break
+		}
+	}
+
+	return &pb.GetTransactionsResponse{Transactions: transactions}, nil
+}
+
+func (s *server) GetTransaction(_ context.Context, request *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	record, ok := s.history.records[request.TransactionId]
+	if !ok {
+		return nil, errors.Errorf("transaction %s is not in the wallet's history", request.TransactionId)
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTransactionResponse{Transaction: transactionHistoryEntry(record, dagInfo.VirtualDAAScore)}, nil
+}
+
+func transactionHistoryEntry(record *transactionRecord, virtualDAAScore uint64) *pb.TransactionHistoryEntry {
+	confirmations := uint64(0)
+	if record.AcceptingDAAScore != 0 && virtualDAAScore > record.AcceptingDAAScore {
+		confirmations = virtualDAAScore - record.AcceptingDAAScore
+	}
+
+	walletAddresses := make([]string, 0, len(record.ReceivedOutputs))
+	for _, output := range record.ReceivedOutputs {
+		if !slices.Contains(walletAddresses, output.Address) {
+			walletAddresses = append(walletAddresses, output.Address)
+		}
+	}
+
+	return &pb.TransactionHistoryEntry{
+		TransactionId:     record.TransactionID,
+		Status:            record.status(),
+		AcceptingDaaScore: record.AcceptingDAAScore,
+		Confirmations:     confirmations,
+		IsOutgoing:        record.IsOutgoing,
+		SentAmount:        record.SentAmount,
+		ReceivedAmount:    record.receivedAmount(),
+		Fee:               record.Fee,
+		Counterparties:    record.Counterparties,
+		WalletAddresses:   walletAddresses,
+		Timestamp:         record.Timestamp,
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+// utxoLocks keeps the outpoints the user locked in a JSON file next to the keys file.
+// Locked outpoints are never selected as inputs of the transactions the daemon creates
+type utxoLocks struct {
+	path  string
+	locks map[externalapi.DomainOutpoint]*utxoLock
+}
+
+type utxoLock struct {
+	TransactionID string `json:"transactionId"`
+	Index         uint32 `json:"index"`
+	Label         string `json:"label,omitempty"`
+	// Timestamp is when the outpoint was locked, in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+}
+
+// utxoLocksFilePath returns the path of the UTXO locks file of the given keys file, which
+// is the keys file path with its extension replaced by .locks.json
+func utxoLocksFilePath(keysFilePath string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + ".locks.json"
+}
+
+func newUTXOLocks(path string) *utxoLocks {
+	return &utxoLocks{
+		path:  path,
+		locks: make(map[externalapi.DomainOutpoint]*utxoLock),
+	}
+}
+
+// readUTXOLocks reads the locks in the given file, or returns
+// no locks if the file doesn't exist yet
+func readUTXOLocks(path string) (*utxoLocks, error) {
+	locks := newUTXOLocks(path)
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return locks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lockList []*utxoLock
+	err = json.NewDecoder(file).Decode(&lockList)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding the UTXO locks file %s", path)
+	}
+
+	for _, lock := range lockList {
+		transactionID, err := transactionid.FromString(lock.TransactionID)
+		if err != nil {
+			return nil, err
+		}
+		locks.locks[externalapi.DomainOutpoint{TransactionID: *transactionID, Index: lock.Index}] = lock
+	}
+
+	return locks, nil
+}
+
+// save writes the locks to their file
+func (ul *utxoLocks) save() error {
+	lockList := make([]*utxoLock, 0, len(ul.locks))
+	for _, lock := range ul.locks {
+		lockList = append(lockList, lock)
+	}
+	sort.Slice(lockList, func(i, j int) bool {
+		if lockList[i].TransactionID != lockList[j].TransactionID {
+			return lockList[i].TransactionID < lockList[j].TransactionID
+		}
+		return lockList[i].Index < lockList[j].Index
+	})
+
+	return writeJSONFile(ul.path, lockList)
+}
+
+// lock locks the given outpoint with the given label, replacing the label it was previously locked with, if any
+func (ul *utxoLocks) lock(outpoint *externalapi.DomainOutpoint, label string) {
+	if lock, ok := ul.locks[*outpoint]; ok {
+		lock.Label = label
+		return
+	}
+
+	ul.locks[*outpoint] = &utxoLock{
+		TransactionID: outpoint.TransactionID.String(),
+		Index:         outpoint.Index,
+		Label:         label,
+		Timestamp:     time.Now().UnixMilli(),
+	}
+}
+
+// unlock unlocks the given outpoint. Outpoints that aren't locked are ignored
+func (ul *utxoLocks) unlock(outpoint *externalapi.DomainOutpoint) {
+	delete(ul.locks, *outpoint)
+}
+
+// get returns the lock of the given outpoint, or nil if it isn't locked
+func (ul *utxoLocks) get(outpoint *externalapi.DomainOutpoint) *utxoLock {
+	return ul.locks[*outpoint]
+}
+
+func (ul *utxoLocks) isLocked(outpoint *externalapi.DomainOutpoint) bool {
+	_, ok := ul.locks[*outpoint]
+	return ok
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+func TestUTXOLocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.locks.json")
+	locks, err := readUTXOLocks(path)
+	if err != nil {
+		t.Fatalf("readUTXOLocks: %+v", err)
+	}
+
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	coldStorageOutpoint := &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: 0}
+	otherOutpoint := &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: 1}
+	locks.lock(coldStorageOutpoint, "cold storage")
+	locks.lock(otherOutpoint, "")
+
+	err = locks.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+	locks, err = readUTXOLocks(path)
+	if err != nil {
+		t.Fatalf("readUTXOLocks: %+v", err)
+	}
+
+	lock := locks.get(coldStorageOutpoint)
+	if lock == nil || lock.Label != "cold storage" {
+		t.Fatalf("The cold storage outpoint should be locked with its label after reading the locks")
+	}
+	if !locks.isLocked(otherOutpoint) {
+		t.Fatalf("The other outpoint should be locked after reading the locks")
+	}
+
+	locks.unlock(otherOutpoint)
+	err = locks.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+	locks, err = readUTXOLocks(path)
+	if err != nil {
+		t.Fatalf("readUTXOLocks: %+v", err)
+	}
+	if locks.isLocked(otherOutpoint) || !locks.isLocked(coldStorageOutpoint) {
+		t.Fatalf("Only the cold storage outpoint should be locked after unlocking the other one")
+	}
+}
+
+func TestUnspendableOutpoints(t *testing.T) {
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	outpoints := make([]*externalapi.DomainOutpoint, 3)
+	serverInstance := &server{
+		utxosByOutpoint: map[externalapi.DomainOutpoint]*walletUTXO{},
+		utxoLocks:       newUTXOLocks(""),
+	}
+	for i := range outpoints {
+		outpoints[i] = &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: uint32(i)}
+		utxo := &walletUTXO{Outpoint: outpoints[i]}
+		serverInstance.utxosSortedByAmount = append(serverInstance.utxosSortedByAmount, utxo)
+		serverInstance.utxosByOutpoint[*outpoints[i]] = utxo
+	}
+	serverInstance.utxoLocks.lock(outpoints[2], "cold storage")
+
+	assertUnspendable := func(testName string, inputs []*externalapi.DomainOutpoint,
+		expectedUnspendable ...*externalapi.DomainOutpoint) {
+
+		unspendable, err := serverInstance.unspendableOutpoints(inputs)
+		if err != nil {
+			t.Fatalf("%s: unspendableOutpoints: %+v", testName, err)
+		}
+		if len(unspendable) != len(expectedUnspendable) {
+			t.Fatalf("%s: expected %d unspendable outpoints but got %d", testName,
+				len(expectedUnspendable), len(unspendable))
+		}
+		for _, outpoint := range expectedUnspendable {
+			if _, ok := unspendable[*outpoint]; !ok {
+				t.Fatalf("%s: expected outpoint %d to be unspendable", testName, outpoint.Index)
+			}
+		}
+	}
+
+	assertUnspendable("no inputs", nil, outpoints[2])
+	assertUnspendable("given inputs", []*externalapi.DomainOutpoint{outpoints[1]}, outpoints[0], outpoints[2])
+
+	_, err := serverInstance.unspendableOutpoints([]*externalapi.DomainOutpoint{outpoints[2]})
+	if err == nil {
+		t.Fatalf("Unexpectedly succeeded to spend a locked UTXO")
+	}
+	unknownOutpoint := &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: 3}
+	_, err = serverInstance.unspendableOutpoints([]*externalapi.DomainOutpoint{unknownOutpoint})
+	if err == nil {
+		t.Fatalf("Unexpectedly succeeded to spend a UTXO that isn't the wallet's")
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+func (s *server) GetUTXOs(_ context.Context, request *pb.GetUTXOsRequest) (*pb.GetUTXOsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	var requestedAddresses map[*walletAddress]struct{}
+	if len(request.Addresses) > 0 {
+		requestedAddresses = make(map[*walletAddress]struct{}, len(request.Addresses))
+		for _, address := range request.Addresses {
+			walletAddress, ok := s.addressSet[address]
+			if !ok {
+				return nil, errors.Errorf("address %s is not a used address of the wallet", address)
+			}
+			requestedAddresses[walletAddress] = struct{}{}
+		}
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	addressStrings := make(map[*walletAddress]string)
+	utxos := []*pb.WalletUtxo{}
+	for _, utxo := range s.utxosSortedByAmount {
+		if requestedAddresses != nil {
+			if _, ok := requestedAddresses[utxo.address]; !ok {
+				continue
+			}
+		}
+
+		addressString, ok := addressStrings[utxo.address]
+		if !ok {
+			addressString, err = s.walletAddressString(utxo.address)
+			if err != nil {
+				return nil, err
+			}
+			addressStrings[utxo.address] = addressString
+		}
+
+		utxos = append(utxos, s.walletUTXOToProto(utxo, addressString, dagInfo.VirtualDAAScore))
+	}
+
+	return &pb.GetUTXOsResponse{Utxos: utxos, VirtualDaaScore: dagInfo.VirtualDAAScore}, nil
+}
+
+func (s *server) walletUTXOToProto(utxo *walletUTXO, address string, virtualDAAScore uint64) *pb.WalletUtxo {
+	maturityDAAScore := uint64(0)
+	if utxo.UTXOEntry.IsCoinbase() {
+		maturityDAAScore = utxo.UTXOEntry.BlockDAAScore() + s.params.BlockCoinbaseMaturity + 1
+	}
+
+	lockLabel := ""
+	lock := s.utxoLocks.get(utxo.Outpoint)
+	if lock != nil {
+		lockLabel = lock.Label
+	}
+
+	return &pb.WalletUtxo{
+		Outpoint: &pb.Outpoint{
+			TransactionId: utxo.Outpoint.TransactionID.String(),
+			Index:         utxo.Outpoint.Index,
+		},
+		Address:                     address,
+		Amount:                      utxo.UTXOEntry.Amount(),
+		BlockDaaScore:               utxo.UTXOEntry.BlockDAAScore(),
+		IsCoinbase:                  utxo.UTXOEntry.IsCoinbase(),
+		IsMature:                    isUTXOSpendable(utxo, virtualDAAScore, s.params.BlockCoinbaseMaturity),
+		MaturityDaaScore:            maturityDAAScore,
+		IsLocked:                    lock != nil,
+		LockLabel:                   lockLabel,
+		IsSpentByPendingTransaction: s.history.pendingTransactionSpending(utxo.Outpoint) != nil,
+	}
+}
+
+func (s *server) LockUTXOs(_ context.Context, request *pb.LockUTXOsRequest) (*pb.LockUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	outpoints, err := decodeOutpoints(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+	// All the outpoints are checked before any of them is locked, so that a
+	// mistyped outpoint doesn't leave the request partially applied
+	for _, outpoint := range outpoints {
+		if _, ok := s.utxosByOutpoint[*outpoint]; !ok {
+			return nil, errors.Errorf("%s:%d is not a UTXO of the wallet", outpoint.TransactionID, outpoint.Index)
+		}
+	}
+
+	for _, outpoint := range outpoints {
+		s.utxoLocks.lock(outpoint, request.Label)
+	}
+	err = s.utxoLocks.save()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LockUTXOsResponse{}, nil
+}
+
+func (s *server) UnlockUTXOs(_ context.Context, request *pb.UnlockUTXOsRequest) (*pb.UnlockUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outpoints, err := decodeOutpoints(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// Outpoints that were spent since they were locked are no longer UTXOs of the
+	// wallet, so unlike LockUTXOs, any outpoint may be unlocked
+	for _, outpoint := range outpoints {
+		s.utxoLocks.unlock(outpoint)
+	}
+	err = s.utxoLocks.save()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockUTXOsResponse{}, nil
+}
+
+func decodeOutpoints(protoOutpoints []*pb.Outpoint) ([]*externalapi.DomainOutpoint, error) {
+	outpoints := make([]*externalapi.DomainOutpoint, len(protoOutpoints))
+	for i, protoOutpoint := range protoOutpoints {
+		transactionID, err := transactionid.FromString(protoOutpoint.TransactionId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction ID %s", protoOutpoint.TransactionId)
+		}
+		outpoints[i] = &externalapi.DomainOutpoint{
+			TransactionID: *transactionID,
+			Index:         protoOutpoint.Index,
+		}
+	}
+	return outpoints, nil
+}
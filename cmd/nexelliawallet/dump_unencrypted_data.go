@@ -25,6 +25,10 @@ func dumpUnencryptedData(conf *dumpUnencryptedDataConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return keys.ErrWatchOnly
+	}
+
 	if len(conf.Password) == 0 {
 		conf.Password = keys.GetPassword("Password:")
 	}
@@ -55,6 +59,7 @@ func dumpUnencryptedData(conf *dumpUnencryptedDataConfig) error {
 	}
 
 	fmt.Printf("Minimum number of signatures: %d\n", keysFile.MinimumSignatures)
+	fmt.Printf("Cosigner index: %d\n", keysFile.CosignerIndex)
 	return nil
 }
 
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/client"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/utils"
+)
+
+func history(conf *historyConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	if conf.TransactionID != "" {
+		response, err := daemonClient.GetTransaction(ctx, &pb.GetTransactionRequest{TransactionId: conf.TransactionID})
+		if err != nil {
+			return err
+		}
+		printTransactionDetails(response.Transaction)
+		return nil
+	}
+
+	response, err := daemonClient.GetTransactions(ctx, &pb.GetTransactionsRequest{
+		Limit:   conf.Limit,
+		Address: conf.Address,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Transactions) == 0 {
+		fmt.Println("No transactions were found")
+		return nil
+	}
+
+	println("Time                 Transaction ID                                                    Status    Confirmations                 Amount")
+	println("-------------------------------------------------------------------------------------------------------------------------------------")
+	for _, transaction := range response.Transactions {
+		fmt.Printf("%s  %s  %-8s  %13d  %s %s\n", formatTimestamp(transaction.Timestamp), transaction.TransactionId,
+			transaction.Status, transaction.Confirmations, transactionDirection(transaction), transactionAmount(transaction))
+	}
+
+	return nil
+}
+
+func printTransactionDetails(transaction *pb.TransactionHistoryEntry) {
+	fmt.Printf("Transaction ID:      %s\n", transaction.TransactionId)
+	fmt.Printf("First seen:          %s\n", formatTimestamp(transaction.Timestamp))
+	fmt.Printf("Status:              %s\n", transaction.Status)
+	if transaction.AcceptingDaaScore != 0 {
+		fmt.Printf("Accepting DAA score: %d\n", transaction.AcceptingDaaScore)
+		fmt.Printf("Confirmations:       %d\n", transaction.Confirmations)
+	}
+	if transaction.IsOutgoing {
+		fmt.Printf("Sent, NEXE           %s\n", utils.FormatKas(transaction.SentAmount))
+		fmt.Printf("Fee, NEXE            %s\n", utils.FormatKas(transaction.Fee))
+		fmt.Printf("Counterparties:      %s\n", strings.Join(transaction.Counterparties, ", "))
+	}
+	fmt.Printf("Received, NEXE       %s\n", utils.FormatKas(transaction.ReceivedAmount))
+	fmt.Printf("Wallet addresses:    %s\n", strings.Join(transaction.WalletAddresses, ", "))
+}
+
+// transactionDirection returns "-" for transactions the wallet sent, and "+" for ones it received
+func transactionDirection(transaction *pb.TransactionHistoryEntry) string {
+	if transaction.IsOutgoing {
+		return "-"
+	}
+	return "+"
+}
+
+// transactionAmount returns the amount the wallet sent, including the fee, for
+// outgoing transactions, and the amount it received for all others
+func transactionAmount(transaction *pb.TransactionHistoryEntry) string {
+	if transaction.IsOutgoing {
+		return utils.FormatKas(transaction.SentAmount + transaction.Fee)
+	}
+	return utils.FormatKas(transaction.ReceivedAmount)
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.UnixMilli(timestamp).Format("2006-01-02 15:04:05")
+}
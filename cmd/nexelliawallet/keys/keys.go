@@ -29,6 +29,9 @@ var (
 // LastVersion is the most up to date file format version
 const LastVersion = 1
 
+// ErrWatchOnly is returned when the private keys of a watch-only keys file are required
+var ErrWatchOnly = errors.New("the keys file is watch-only: it has no private keys, so it cannot be used for signing")
+
 func defaultKeysFile(netParams *dagconfig.Params) string {
 	return filepath.Join(defaultAppDir, netParams.Name, "keys.json")
 }
@@ -206,23 +209,27 @@ func (d *File) LastUsedInternalIndex() uint32 {
 	return d.lastUsedInternalIndex
 }
 
+// IsWatchOnly returns whether the file holds only extended public keys. Watch-only files
+// can be used to track the balance and create unsigned transactions, but not to sign them
+func (d *File) IsWatchOnly() bool {
+	return len(d.EncryptedMnemonics) == 0
+}
+
 // DecryptMnemonics asks the user to enter the password for the private keys and
 // returns the decrypted private keys.
 func (d *File) DecryptMnemonics(password string) ([]string, error) {
-	passwordBytes := []byte(password)
+	if d.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
 
-	var numThreads uint8
-	if len(d.EncryptedMnemonics) > 0 {
-		var err error
-		numThreads, err = d.numThreads(passwordBytes)
-		if err != nil {
-			return nil, err
-		}
+	passwordBytes := []byte(password)
+	numThreads, err := d.numThreads(passwordBytes)
+	if err != nil {
+		return nil, err
 	}
 
 	privateKeys := make([]string, len(d.EncryptedMnemonics))
 	for i, encryptedPrivateKey := range d.EncryptedMnemonics {
-		var err error
 		privateKeys[i], err = decryptMnemonic(numThreads, encryptedPrivateKey, passwordBytes)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,80 @@
+package libkaspawallet
+
+import (
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet/serialization"
+	"github.com/pkg/errors"
+)
+
+// CombineSignatures merges the signatures of several copies of the same partially signed
+// transaction, each signed independently by some of the cosigners, into a single copy
+func CombineSignatures(serializedPSTxs [][]byte) ([]byte, error) {
+	if len(serializedPSTxs) == 0 {
+		return nil, errors.Errorf("no transactions to combine")
+	}
+
+	combined, err := serialization.DeserializePartiallySignedTransaction(serializedPSTxs[0])
+	if err != nil {
+		return nil, err
+	}
+	setSigOpCounts(combined)
+
+	for i, serializedPSTx := range serializedPSTxs[1:] {
+		partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(serializedPSTx)
+		if err != nil {
+			return nil, err
+		}
+		setSigOpCounts(partiallySignedTransaction)
+
+		err = combineSignatures(combined, partiallySignedTransaction)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot combine transaction #%d with transaction #1", i+2)
+		}
+	}
+
+	return serialization.SerializePartiallySignedTransaction(combined)
+}
+
+// setSigOpCounts sets the signature operation count of each input, the same way signing does.
+// Signatures commit to it, so it must be set in the combined transaction even if its
+// first copy was never signed
+func setSigOpCounts(partiallySignedTransaction *serialization.PartiallySignedTransaction) {
+	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		partiallySignedTransaction.Tx.Inputs[i].SigOpCount = byte(len(partiallySignedInput.PubKeySignaturePairs))
+	}
+}
+
+// combineSignatures adds the signatures of other that are missing in combined. It returns an
+// error if the two aren't copies of the same transaction with the same cosigners
+func combineSignatures(combined, other *serialization.PartiallySignedTransaction) error {
+	if !combined.Tx.Equal(other.Tx) {
+		return errors.Errorf("the transactions are different")
+	}
+	if len(combined.PartiallySignedInputs) != len(other.PartiallySignedInputs) {
+		return errors.Errorf("the transactions have a different number of partially signed inputs")
+	}
+
+	for i, combinedInput := range combined.PartiallySignedInputs {
+		otherInput := other.PartiallySignedInputs[i]
+		if !combinedInput.PrevOutput.Equal(otherInput.PrevOutput) ||
+			combinedInput.MinimumSignatures != otherInput.MinimumSignatures ||
+			combinedInput.DerivationPath != otherInput.DerivationPath ||
+			len(combinedInput.PubKeySignaturePairs) != len(otherInput.PubKeySignaturePairs) {
+
+			return errors.Errorf("input %d is different", i)
+		}
+
+		for j, combinedPair := range combinedInput.PubKeySignaturePairs {
+			otherPair := otherInput.PubKeySignaturePairs[j]
+			if combinedPair.ExtendedPublicKey != otherPair.ExtendedPublicKey {
+				return errors.Errorf("input %d has different public keys", i)
+			}
+			// Signatures aren't deterministic, so two copies signed with the same key may
+			// have different signatures. Both are valid, so the first one is kept
+			if combinedPair.Signature == nil {
+				combinedPair.Signature = otherPair.Signature
+			}
+		}
+	}
+
+	return nil
+}
@@ -166,6 +166,48 @@ func TestMultisig(t *testing.T) {
 				t.Fatalf("Expected extractedSignedTxOneStep and extractedSignedTxStep2 IDs to be equal")
 			}
 
+			signedTxByCosigner2, err := libkaspawallet.Sign(params, mnemonics[1:2], unsignedTransaction, ecdsa)
+			if err != nil {
+				t.Fatalf("Sign: %+v", err)
+			}
+
+			combinedTx, err := libkaspawallet.CombineSignatures([][]byte{unsignedTransaction, signedTxStep1, signedTxByCosigner2})
+			if err != nil {
+				t.Fatalf("CombineSignatures: %+v", err)
+			}
+
+			isFullySigned, err = libkaspawallet.IsTransactionFullySigned(combinedTx)
+			if err != nil {
+				t.Fatalf("IsTransactionFullySigned: %+v", err)
+			}
+
+			if !isFullySigned {
+				t.Fatalf("The combined transaction is expected to be fully signed")
+			}
+
+			extractedCombinedTx, err := libkaspawallet.ExtractTransaction(combinedTx, ecdsa)
+			if err != nil {
+				t.Fatalf("ExtractTransaction: %+v", err)
+			}
+
+			if !consensushashing.TransactionID(extractedCombinedTx).Equal(consensushashing.TransactionID(extractedSignedTxOneStep)) {
+				t.Fatalf("Expected extractedCombinedTx and extractedSignedTxOneStep IDs to be equal")
+			}
+
+			otherUnsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(publicKeys, minimumSignatures,
+				[]*libkaspawallet.Payment{{
+					Address: address,
+					Amount:  20,
+				}}, selectedUTXOs)
+			if err != nil {
+				t.Fatalf("CreateUnsignedTransactions: %+v", err)
+			}
+
+			_, err = libkaspawallet.CombineSignatures([][]byte{signedTxStep1, otherUnsignedTransaction})
+			if err == nil {
+				t.Fatalf("Unexpectedly succeeded to combine different transactions")
+			}
+
 			_, virtualChangeSet, err := tc.AddBlock([]*externalapi.DomainHash{block1Hash}, nil, []*externalapi.DomainTransaction{extractedSignedTxStep2})
 			if err != nil {
 				t.Fatalf("AddBlock: %+v", err)
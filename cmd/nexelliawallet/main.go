@@ -17,6 +17,8 @@ func main() {
 		err = createUnsignedTransaction(config.(*createUnsignedTransactionConfig))
 	case signSubCmd:
 		err = sign(config.(*signConfig))
+	case combineSubCmd:
+		err = combine(config.(*combineConfig))
 	case broadcastSubCmd:
 		err = broadcast(config.(*broadcastConfig))
 	case parseSubCmd:
@@ -25,6 +27,14 @@ func main() {
 		err = showAddresses(config.(*showAddressesConfig))
 	case newAddressSubCmd:
 		err = newAddress(config.(*newAddressConfig))
+	case historySubCmd:
+		err = history(config.(*historyConfig))
+	case utxosSubCmd:
+		err = utxos(config.(*utxosConfig))
+	case lockUTXOsSubCmd:
+		err = lockUTXOs(config.(*lockUTXOsConfig))
+	case unlockUTXOsSubCmd:
+		err = unlockUTXOs(config.(*unlockUTXOsConfig))
 	case dumpUnencryptedDataSubCmd:
 		err = dumpUnencryptedData(config.(*dumpUnencryptedDataConfig))
 	case startDaemonSubCmd:
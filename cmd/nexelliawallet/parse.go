@@ -30,7 +30,7 @@ func parse(conf *parseConfig) error {
 		transactionHex = strings.TrimSpace(string(transactionHexBytes))
 	}
 
-	transactions, err := decodeTransactionsFromHex(transactionHex)
+	transactions, err := decodeTransactions(transactionHex)
 	if err != nil {
 		return err
 	}
@@ -51,6 +51,7 @@ func parse(conf *parseConfig) error {
 			if conf.Verbose {
 				fmt.Printf("Input %d: \tOutpoint: %s:%d \tAmount: %.2f Kaspa\n", index, input.PreviousOutpoint.TransactionID,
 					input.PreviousOutpoint.Index, float64(partiallySignedInput.PrevOutput.Value)/float64(constants.SompiPerKaspa))
+				printInputSignatures(partiallySignedInput)
 			}
 
 			allInputSompi += partiallySignedInput.PrevOutput.Value
@@ -84,3 +85,30 @@ func parse(conf *parseConfig) error {
 
 	return nil
 }
+
+// printInputSignatures prints which of the cosigners of the given input already signed it,
+// and how many more signatures it needs. The cosigners are listed by the order of their
+// public keys, which are derived from their extended public keys with the input's derivation path
+func printInputSignatures(partiallySignedInput *serialization.PartiallySignedInput) {
+	signatureCount := uint32(0)
+	for _, pair := range partiallySignedInput.PubKeySignaturePairs {
+		if pair.Signature != nil {
+			signatureCount++
+		}
+	}
+
+	missingSignatures := "fully signed"
+	if signatureCount < partiallySignedInput.MinimumSignatures {
+		missingSignatures = fmt.Sprintf("%d more needed", partiallySignedInput.MinimumSignatures-signatureCount)
+	}
+	fmt.Printf("\tSignatures: %d of %d required (%s)\n",
+		signatureCount, partiallySignedInput.MinimumSignatures, missingSignatures)
+
+	for i, pair := range partiallySignedInput.PubKeySignaturePairs {
+		status := "not signed"
+		if pair.Signature != nil {
+			status = "signed"
+		}
+		fmt.Printf("\t\tCosigner %d: %-10s \t%s\n", i+1, status, pair.ExtendedPublicKey)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/utils"
+	"github.com/pkg/errors"
+)
+
+// parsePayments returns the payments given by the --to flags, followed by the ones in paymentFile
+func parsePayments(to []string, paymentFile string) ([]*pb.Payment, error) {
+	payments := make([]*pb.Payment, 0, len(to))
+	for _, toFlag := range to {
+		// Addresses contain a colon themselves, so the amount is whatever follows the last one
+		separatorIndex := strings.LastIndex(toFlag, ":")
+		if separatorIndex == -1 {
+			return nil, errors.Errorf("'--to %s' is not in the form address:amount", toFlag)
+		}
+		payment, err := newPayment(toFlag[:separatorIndex], toFlag[separatorIndex+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid '--to %s'", toFlag)
+		}
+		payments = append(payments, payment)
+	}
+
+	if paymentFile != "" {
+		filePayments, err := readPaymentFile(paymentFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading the payment file %s", paymentFile)
+		}
+		payments = append(payments, filePayments...)
+	}
+
+	return payments, nil
+}
+
+// readPaymentFile reads the payments in the given file. Files with a .json extension
+// are read as JSON, and all others as CSV
+func readPaymentFile(path string) ([]*pb.Payment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return readJSONPayments(file)
+	}
+	return readCSVPayments(file)
+}
+
+// readJSONPayments reads an array of {"address": "nexellia:...", "amount": 1234.12345678} objects
+func readJSONPayments(reader io.Reader) ([]*pb.Payment, error) {
+	var jsonPayments []struct {
+		Address string      `json:"address"`
+		Amount  json.Number `json:"amount"`
+	}
+	err := json.NewDecoder(reader).Decode(&jsonPayments)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*pb.Payment, len(jsonPayments))
+	for i, jsonPayment := range jsonPayments {
+		payments[i], err = newPayment(jsonPayment.Address, jsonPayment.Amount.String())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid payment #%d", i+1)
+		}
+	}
+	return payments, nil
+}
+
+// readCSVPayments reads address,amount records. Lines starting with # are
+// ignored, and so is a first line that is an address,amount header
+func readCSVPayments(reader io.Reader) ([]*pb.Payment, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 && strings.EqualFold(records[0][0], "address") && strings.EqualFold(records[0][1], "amount") {
+		records = records[1:]
+	}
+
+	payments := make([]*pb.Payment, len(records))
+	for i, record := range records {
+		payments[i], err = newPayment(record[0], record[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid payment %s", strings.Join(record, ","))
+		}
+	}
+	return payments, nil
+}
+
+func newPayment(address string, amount string) (*pb.Payment, error) {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return nil, errors.New("the address is missing")
+	}
+	amountSompi, err := utils.KasToSompi(amount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse the amount %s", amount)
+	}
+	if amountSompi == 0 {
+		return nil, errors.Errorf("the amount must be positive, but it's %s", amount)
+	}
+
+	return &pb.Payment{
+		Address: address,
+		Amount:  amountSompi,
+	}, nil
+}
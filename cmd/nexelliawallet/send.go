@@ -20,6 +20,10 @@ func send(conf *sendConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return errors.Errorf("Cannot use 'send' command for a watch-only wallet. Use 'create-unsigned-transaction', " +
+			"sign the transaction on a machine with the private keys, and then use 'broadcast'")
+	}
 	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
 		return errors.Errorf("Cannot use 'send' command for multisig wallet without all of the keys")
 	}
@@ -38,13 +42,25 @@ func send(conf *sendConfig) error {
 		sendAmountSompi = uint64(conf.SendAmount * constants.SompiPerKaspa)
 	}
 
+	payments, err := parsePayments(conf.To, conf.PaymentFile)
+	if err != nil {
+		return err
+	}
+	inputs, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
 	createUnsignedTransactionsResponse, err :=
 		daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 			From:                     conf.FromAddresses,
 			Address:                  conf.ToAddress,
 			Amount:                   sendAmountSompi,
+			Payments:                 payments,
+			Utxos:                    inputs,
 			IsSendAll:                conf.IsSendAll,
 			UseExistingChangeAddress: conf.UseExistingChangeAddress,
+			FeePriority:              conf.FeePriority,
 		})
 	if err != nil {
 		return err
@@ -24,6 +24,10 @@ func sign(conf *signConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return keys.ErrWatchOnly
+	}
+
 	if len(conf.Password) == 0 {
 		conf.Password = keys.GetPassword("Password:")
 	}
@@ -40,7 +44,7 @@ func sign(conf *signConfig) error {
 		}
 		transactionsHex = strings.TrimSpace(string(transactionHexBytes))
 	}
-	partiallySignedTransactions, err := decodeTransactionsFromHex(transactionsHex)
+	partiallySignedTransactions, err := decodeTransactions(transactionsHex)
 	if err != nil {
 		return err
 	}
@@ -72,6 +76,10 @@ func sign(conf *signConfig) error {
 		fmt.Fprintln(os.Stderr, "Successfully signed transaction")
 	}
 
-	fmt.Println(encodeTransactionsToHex(updatedPartiallySignedTransactions))
+	encodedTransactions, err := encodeTransactions(updatedPartiallySignedTransactions, conf.JSON)
+	if err != nil {
+		return err
+	}
+	fmt.Println(encodedTransactions)
 	return nil
 }
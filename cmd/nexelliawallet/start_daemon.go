@@ -3,5 +3,9 @@ package main
 import "github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/server"
 
 func startDaemon(conf *startDaemonConfig) error {
-	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, conf.KeysFile, conf.Profile, conf.Timeout)
+	rpcConnectOptions, err := conf.RPCConnectOptions()
+	if err != nil {
+		return err
+	}
+	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, rpcConnectOptions, conf.KeysFile, conf.Profile, conf.Timeout)
 }
@@ -2,7 +2,12 @@ package main
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"strings"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/libkaspawallet/serialization/protoserialization"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // hexTransactionsSeparator is used to mark the end of one transaction and the beginning of the next one.
@@ -31,3 +36,67 @@ func decodeTransactionsFromHex(transactionsHex string) ([][]byte, error) {
 
 	return transactions, nil
 }
+
+// encodeTransactionsToJSON encodes the given partially signed transactions as a JSON array,
+// so that cosigners can review them before signing
+func encodeTransactionsToJSON(transactions [][]byte) (string, error) {
+	transactionsInJSON := make([]json.RawMessage, len(transactions))
+	for i, transaction := range transactions {
+		protoPartiallySignedTransaction := &protoserialization.PartiallySignedTransaction{}
+		err := proto.Unmarshal(transaction, protoPartiallySignedTransaction)
+		if err != nil {
+			return "", err
+		}
+		transactionsInJSON[i], err = protojson.Marshal(protoPartiallySignedTransaction)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	transactionsJSON, err := json.MarshalIndent(transactionsInJSON, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(transactionsJSON), nil
+}
+
+func decodeTransactionsFromJSON(transactionsJSON string) ([][]byte, error) {
+	var transactionsInJSON []json.RawMessage
+	err := json.Unmarshal([]byte(transactionsJSON), &transactionsInJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([][]byte, len(transactionsInJSON))
+	for i, transactionInJSON := range transactionsInJSON {
+		protoPartiallySignedTransaction := &protoserialization.PartiallySignedTransaction{}
+		err := protojson.Unmarshal(transactionInJSON, protoPartiallySignedTransaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions[i], err = proto.Marshal(protoPartiallySignedTransaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return transactions, nil
+}
+
+// encodeTransactions encodes the given transactions in JSON if isJSON is set, and in hex otherwise
+func encodeTransactions(transactions [][]byte, isJSON bool) (string, error) {
+	if isJSON {
+		return encodeTransactionsToJSON(transactions)
+	}
+	return encodeTransactionsToHex(transactions), nil
+}
+
+// decodeTransactions decodes transactions encoded by encodeTransactions in either encoding
+func decodeTransactions(encodedTransactions string) ([][]byte, error) {
+	encodedTransactions = strings.TrimSpace(encodedTransactions)
+	// A JSON array always starts with a bracket, which is neither in the hex alphabet nor the hex separator
+	if strings.HasPrefix(encodedTransactions, "[") {
+		return decodeTransactionsFromJSON(encodedTransactions)
+	}
+	return decodeTransactionsFromHex(encodedTransactions)
+}
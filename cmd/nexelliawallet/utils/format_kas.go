@@ -2,10 +2,16 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
+	"github.com/pkg/errors"
 )
 
+// sompiDecimalPlaces is the number of decimal places of an amount of KAS that can be expressed in sompis
+const sompiDecimalPlaces = 8
+
 // FormatKas takes the amount of sompis as uint64, and returns amount of KAS with 8  decimal places
 func FormatKas(amount uint64) string {
 	res := "                   "
@@ -14,3 +20,51 @@ func FormatKas(amount uint64) string {
 	}
 	return res
 }
+
+// KasToSompi parses an amount of KAS with up to 8 decimal places, and returns the amount of sompis.
+// The amount is parsed as a decimal string rather than a float, so that it's converted exactly
+func KasToSompi(amount string) (uint64, error) {
+	amount = strings.TrimSpace(amount)
+	integerPart, fractionalPart := amount, ""
+	if separatorIndex := strings.Index(amount, "."); separatorIndex != -1 {
+		integerPart, fractionalPart = amount[:separatorIndex], amount[separatorIndex+1:]
+	}
+	if integerPart == "" && fractionalPart == "" {
+		return 0, errors.Errorf("invalid amount '%s'", amount)
+	}
+	if len(fractionalPart) > sompiDecimalPlaces {
+		return 0, errors.Errorf("the amount %s has more than %d decimal places", amount, sompiDecimalPlaces)
+	}
+	for _, digits := range []string{integerPart, fractionalPart} {
+		for _, digit := range digits {
+			if digit < '0' || digit > '9' {
+				return 0, errors.Errorf("invalid amount '%s'", amount)
+			}
+		}
+	}
+
+	// Both parts are made only of digits by now, so parsing them can only fail if they're too large
+	kas := uint64(0)
+	if integerPart != "" {
+		var err error
+		kas, err = strconv.ParseUint(integerPart, 10, 64)
+		if err != nil || kas > constants.MaxSompi/constants.SompiPerKaspa {
+			return 0, errors.Errorf("the amount %s is larger than the max allowed amount", amount)
+		}
+	}
+	sompiFraction := uint64(0)
+	if fractionalPart != "" {
+		fractionalPart += strings.Repeat("0", sompiDecimalPlaces-len(fractionalPart))
+		var err error
+		sompiFraction, err = strconv.ParseUint(fractionalPart, 10, 64)
+		if err != nil {
+			return 0, errors.Errorf("invalid amount '%s'", amount)
+		}
+	}
+
+	sompi := kas*constants.SompiPerKaspa + sompiFraction
+	if sompi > constants.MaxSompi {
+		return 0, errors.Errorf("the amount %s is larger than the max allowed amount", amount)
+	}
+	return sompi, nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
+)
+
+func TestKasToSompi(t *testing.T) {
+	tests := []struct {
+		amount        string
+		expectedSompi uint64
+		expectedError bool
+	}{
+		{amount: "1", expectedSompi: 100000000},
+		{amount: " 1.15 ", expectedSompi: 115000000},
+		{amount: "0.29", expectedSompi: 29000000},
+		{amount: "0.00000001", expectedSompi: 1},
+		{amount: ".5", expectedSompi: 50000000},
+		{amount: "2.", expectedSompi: 200000000},
+		{amount: "4961000000", expectedSompi: constants.MaxSompi},
+		{amount: "0.000000001", expectedError: true},
+		{amount: "4961000000.00000001", expectedError: true},
+		{amount: "99999999999999999999", expectedError: true},
+		{amount: "1e-3", expectedError: true},
+		{amount: "-1", expectedError: true},
+		{amount: "1.2.3", expectedError: true},
+		{amount: ".", expectedError: true},
+		{amount: "", expectedError: true},
+	}
+
+	for _, test := range tests {
+		sompi, err := KasToSompi(test.amount)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("KasToSompi(%q): expected an error but got %d", test.amount, sompi)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("KasToSompi(%q): %s", test.amount, err)
+			continue
+		}
+		if sompi != test.expectedSompi {
+			t.Errorf("KasToSompi(%q): expected %d but got %d", test.amount, test.expectedSompi, sompi)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/client"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/daemon/pb"
+	"github.com/shatll-s/nexelliad/cmd/nexelliawallet/utils"
+	"github.com/pkg/errors"
+)
+
+func utxos(conf *utxosConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.GetUTXOs(ctx, &pb.GetUTXOsRequest{Addresses: conf.Addresses})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Utxos) == 0 {
+		fmt.Println("No UTXOs were found")
+		return nil
+	}
+
+	fmt.Printf("%-69s %19s %12s  %-9s  %s\n", "Outpoint", "Amount, NEXE", "DAA score", "Status", "Address")
+	fmt.Println(strings.Repeat("-", 185))
+	var total, locked uint64
+	for _, utxo := range response.Utxos {
+		status, notes := utxoStatus(utxo)
+		line := fmt.Sprintf("%-69s %s %12d  %-9s  %s", formatOutpoint(utxo.Outpoint), utils.FormatKas(utxo.Amount),
+			utxo.BlockDaaScore, status, utxo.Address)
+		if len(notes) > 0 {
+			line += "  (" + strings.Join(notes, "; ") + ")"
+		}
+		fmt.Println(line)
+
+		total += utxo.Amount
+		if utxo.IsLocked {
+			locked += utxo.Amount
+		}
+	}
+	fmt.Println()
+	fmt.Printf("%d UTXOs, NEXE %s, of which locked %s\n", len(response.Utxos), utils.FormatKas(total),
+		utils.FormatKas(locked))
+
+	return nil
+}
+
+// utxoStatus returns the status that determines whether the given UTXO can be spent,
+// along with notes on anything else that prevents it from being spent
+func utxoStatus(utxo *pb.WalletUtxo) (status string, notes []string) {
+	status = "spendable"
+	if !utxo.IsMature {
+		status = "immature"
+		notes = append(notes, fmt.Sprintf("matures at DAA score %d", utxo.MaturityDaaScore))
+	}
+	if utxo.IsSpentByPendingTransaction {
+		status = "pending"
+		notes = append(notes, "spent by a pending transaction")
+	}
+	if utxo.IsLocked {
+		status = "locked"
+		if utxo.LockLabel != "" {
+			notes = append(notes, "locked: "+utxo.LockLabel)
+		}
+	}
+	return status, notes
+}
+
+func lockUTXOs(conf *lockUTXOsConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.LockUTXOs(ctx, &pb.LockUTXOsRequest{Outpoints: outpoints, Label: conf.Label})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Locked %d UTXOs\n", len(outpoints))
+	return nil
+}
+
+func unlockUTXOs(conf *unlockUTXOsConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.UnlockUTXOs(ctx, &pb.UnlockUTXOsRequest{Outpoints: outpoints})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Unlocked %d UTXOs\n", len(outpoints))
+	return nil
+}
+
+// parseOutpoints parses the given --utxo flags, each in the form transactionID:index
+func parseOutpoints(utxoFlags []string) ([]*pb.Outpoint, error) {
+	outpoints := make([]*pb.Outpoint, len(utxoFlags))
+	for i, utxoFlag := range utxoFlags {
+		separatorIndex := strings.LastIndex(utxoFlag, ":")
+		if separatorIndex == -1 {
+			return nil, errors.Errorf("'--utxo %s' is not in the form transactionID:index", utxoFlag)
+		}
+		index, err := strconv.ParseUint(utxoFlag[separatorIndex+1:], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid index in '--utxo %s'", utxoFlag)
+		}
+		outpoints[i] = &pb.Outpoint{
+			TransactionId: utxoFlag[:separatorIndex],
+			Index:         uint32(index),
+		}
+	}
+	return outpoints, nil
+}
+
+func formatOutpoint(outpoint *pb.Outpoint) string {
+	return fmt.Sprintf("%s:%d", outpoint.TransactionId, outpoint.Index)
+}
@@ -0,0 +1,199 @@
+// Package chainindex holds what the indexes that are built from the acceptance data
+// of the virtual selected parent chain have in common: catching up with consensus,
+// rebuilding them from the pruning point, and walking the acceptance data of chain
+// blocks
+package chainindex
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+)
+
+// chainBlocksChunkSize is the amount of chain blocks whose acceptance data
+// is requested from consensus at once. We use chunks in order to avoid
+// blocking consensus for too long
+const chainBlocksChunkSize = 1000
+
+// Index is what Sync needs from an index in order to bring it up to date with consensus
+type Index struct {
+	// VirtualSelectedParent returns the virtual selected parent the index was last
+	// updated with, or a database not found error for an index that was never synced
+	VirtualSelectedParent func() (*externalapi.DomainHash, error)
+
+	// RemoveChainBlocks and AddChainBlocks stage the changes of chain blocks that
+	// left or joined the virtual selected parent chain
+	RemoveChainBlocks func(chainBlocks []*externalapi.DomainHash) error
+	AddChainBlocks    func(chainBlocks []*externalapi.DomainHash) error
+
+	UpdateVirtualSelectedParent func(virtualSelectedParent *externalapi.DomainHash)
+	Commit                      func() error
+	Discard                     func()
+
+	// Reset rebuilds the index from the virtual selected parent chain above the pruning point
+	Reset func() error
+}
+
+// Sync brings the given index up to date with consensus. An index that was never synced
+// is reset. Otherwise, it catches up from the virtual selected parent it was last updated
+// with, so that whatever it holds on chain blocks below the pruning point is kept. The
+// index is reset only if it can't catch up, for example because the chain blocks it
+// has to catch up with were pruned in the meantime.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func Sync(consensus externalapi.Consensus, index *Index) error {
+	indexVirtualSelectedParent, err := index.VirtualSelectedParent()
+	if err != nil {
+		if !database.IsNotFoundError(err) {
+			return err
+		}
+		return index.Reset()
+	}
+
+	virtualSelectedParent, err := consensus.GetVirtualSelectedParent()
+	if err != nil {
+		return err
+	}
+	if virtualSelectedParent.Equal(indexVirtualSelectedParent) {
+		return nil
+	}
+
+	err = catchUp(consensus, index, indexVirtualSelectedParent, virtualSelectedParent)
+	if err != nil {
+		log.Warnf("Could not catch up from virtual selected parent %s, resetting the index instead: %s",
+			indexVirtualSelectedParent, err)
+		index.Discard()
+		return index.Reset()
+	}
+	return nil
+}
+
+// catchUp removes the chain blocks that left the virtual selected parent chain since
+// indexVirtualSelectedParent and adds the ones that joined it, committing every chunk
+// along with the virtual selected parent the index is synced to after it
+func catchUp(consensus externalapi.Consensus, index *Index,
+	indexVirtualSelectedParent *externalapi.DomainHash, virtualSelectedParent *externalapi.DomainHash) error {
+
+	chainPath, err := consensus.GetVirtualSelectedParentChainFromBlock(indexVirtualSelectedParent)
+	if err != nil {
+		return err
+	}
+
+	// The removed chain blocks are committed along with the first chunk of added
+	// ones, so that no commit leaves the index between the two chains
+	err = index.RemoveChainBlocks(chainPath.Removed)
+	if err != nil {
+		return err
+	}
+
+	if len(chainPath.Added) == 0 {
+		index.UpdateVirtualSelectedParent(virtualSelectedParent)
+		return index.Commit()
+	}
+
+	return forEachChunk(chainPath.Added, func(chainBlocksChunk []*externalapi.DomainHash) error {
+		err := index.AddChainBlocks(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+		index.UpdateVirtualSelectedParent(chainBlocksChunk[len(chainBlocksChunk)-1])
+		return index.Commit()
+	})
+}
+
+// ForEachChunkAbovePruningPoint calls f with the virtual selected parent chain above the
+// pruning point, in chunks, so that an index that is being rebuilt can commit each chunk
+// separately. It returns the virtual selected parent the chain ends at, which is taken
+// from the same chain path, so that blocks added to consensus in the meantime can't make
+// the two disagree
+func ForEachChunkAbovePruningPoint(consensus externalapi.Consensus,
+	f func(chainBlocksChunk []*externalapi.DomainHash) error) (*externalapi.DomainHash, error) {
+
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, err
+	}
+	return ForEachChunkAboveBlock(consensus, pruningPoint, f)
+}
+
+// ForEachChunkAboveBlock is ForEachChunkAbovePruningPoint for the virtual selected parent
+// chain above the given chain block, for indexes that need to know which pruning point
+// they were rebuilt from
+func ForEachChunkAboveBlock(consensus externalapi.Consensus, chainBlock *externalapi.DomainHash,
+	f func(chainBlocksChunk []*externalapi.DomainHash) error) (*externalapi.DomainHash, error) {
+
+	chainPath, err := consensus.GetVirtualSelectedParentChainFromBlock(chainBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEachChunk(chainPath.Added, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chainPath.Added) == 0 {
+		return chainBlock, nil
+	}
+	return chainPath.Added[len(chainPath.Added)-1], nil
+}
+
+// ForEachChainBlockAcceptanceData calls f with the acceptance data of every one of the
+// given chain blocks, in order
+func ForEachChainBlockAcceptanceData(consensus externalapi.Consensus, chainBlocks []*externalapi.DomainHash,
+	f func(chainBlockHash *externalapi.DomainHash, chainBlockAcceptanceData externalapi.AcceptanceData) error) error {
+
+	return forEachChunk(chainBlocks, func(chainBlocksChunk []*externalapi.DomainHash) error {
+		chainBlocksAcceptanceData, err := consensus.GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		for i, chainBlockAcceptanceData := range chainBlocksAcceptanceData {
+			err := f(chainBlocksChunk[i], chainBlockAcceptanceData)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ForEachAcceptedTransaction calls f with every transaction accepted by the given chain
+// blocks, along with the chain block that accepted it and the block that included it
+func ForEachAcceptedTransaction(consensus externalapi.Consensus, chainBlocks []*externalapi.DomainHash,
+	f func(acceptingBlockHash *externalapi.DomainHash, includingBlockHash *externalapi.DomainHash,
+		transactionAcceptanceData *externalapi.TransactionAcceptanceData) error) error {
+
+	return ForEachChainBlockAcceptanceData(consensus, chainBlocks,
+		func(chainBlockHash *externalapi.DomainHash, chainBlockAcceptanceData externalapi.AcceptanceData) error {
+			for _, blockAcceptanceData := range chainBlockAcceptanceData {
+				for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+					if !transactionAcceptanceData.IsAccepted {
+						continue
+					}
+					err := f(chainBlockHash, blockAcceptanceData.BlockHash, transactionAcceptanceData)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+}
+
+func forEachChunk(chainBlocks []*externalapi.DomainHash,
+	f func(chainBlocksChunk []*externalapi.DomainHash) error) error {
+
+	for position := 0; position < len(chainBlocks); position += chainBlocksChunkSize {
+		end := position + chainBlocksChunkSize
+		if end > len(chainBlocks) {
+			end = len(chainBlocks)
+		}
+
+		err := f(chainBlocks[position:end])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
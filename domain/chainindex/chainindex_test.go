@@ -0,0 +1,166 @@
+package chainindex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+func TestForEachChunk(t *testing.T) {
+	for _, chainBlocksAmount := range []int{0, 1, chainBlocksChunkSize, chainBlocksChunkSize + 1, 2*chainBlocksChunkSize + 7} {
+		chainBlocks := make([]*externalapi.DomainHash, chainBlocksAmount)
+		for i := range chainBlocks {
+			var hashBytes [externalapi.DomainHashSize]byte
+			hashBytes[0] = byte(i)
+			hashBytes[1] = byte(i >> 8)
+			chainBlocks[i] = externalapi.NewDomainHashFromByteArray(&hashBytes)
+		}
+
+		var visited []*externalapi.DomainHash
+		err := forEachChunk(chainBlocks, func(chainBlocksChunk []*externalapi.DomainHash) error {
+			if len(chainBlocksChunk) == 0 || len(chainBlocksChunk) > chainBlocksChunkSize {
+				t.Fatalf("forEachChunk(%d): unexpected chunk length %d", chainBlocksAmount, len(chainBlocksChunk))
+			}
+			visited = append(visited, chainBlocksChunk...)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("forEachChunk(%d): %s", chainBlocksAmount, err)
+		}
+
+		if len(visited) != len(chainBlocks) {
+			t.Fatalf("forEachChunk(%d): expected %d chain blocks but got %d",
+				chainBlocksAmount, len(chainBlocks), len(visited))
+		}
+		for i := range chainBlocks {
+			if !visited[i].Equal(chainBlocks[i]) {
+				t.Fatalf("forEachChunk(%d): chain block %d is out of order", chainBlocksAmount, i)
+			}
+		}
+	}
+}
+
+// syncTestConsensus is a consensus whose virtual selected parent chain from every
+// block is known in advance, implementing only what Sync uses
+type syncTestConsensus struct {
+	externalapi.Consensus
+
+	virtualSelectedParent *externalapi.DomainHash
+	chainPaths            map[externalapi.DomainHash]*externalapi.SelectedChainPath
+}
+
+func (stc *syncTestConsensus) GetVirtualSelectedParent() (*externalapi.DomainHash, error) {
+	return stc.virtualSelectedParent, nil
+}
+
+func (stc *syncTestConsensus) GetVirtualSelectedParentChainFromBlock(
+	blockHash *externalapi.DomainHash) (*externalapi.SelectedChainPath, error) {
+
+	chainPath, ok := stc.chainPaths[*blockHash]
+	if !ok {
+		return nil, errors.Errorf("block %s was pruned", blockHash)
+	}
+	return chainPath, nil
+}
+
+// syncTestIndex records what Sync did with it
+type syncTestIndex struct {
+	virtualSelectedParent *externalapi.DomainHash
+	staged                []string
+	committed             []string
+	isReset               bool
+}
+
+func (sti *syncTestIndex) index() *Index {
+	return &Index{
+		VirtualSelectedParent: func() (*externalapi.DomainHash, error) {
+			if sti.virtualSelectedParent == nil {
+				return nil, database.ErrNotFound
+			}
+			return sti.virtualSelectedParent, nil
+		},
+		RemoveChainBlocks: func(chainBlocks []*externalapi.DomainHash) error {
+			for _, chainBlock := range chainBlocks {
+				sti.staged = append(sti.staged, "-"+chainBlock.String())
+			}
+			return nil
+		},
+		AddChainBlocks: func(chainBlocks []*externalapi.DomainHash) error {
+			for _, chainBlock := range chainBlocks {
+				sti.staged = append(sti.staged, "+"+chainBlock.String())
+			}
+			return nil
+		},
+		UpdateVirtualSelectedParent: func(virtualSelectedParent *externalapi.DomainHash) {
+			sti.virtualSelectedParent = virtualSelectedParent
+		},
+		Commit: func() error {
+			sti.committed = append(sti.committed, sti.staged...)
+			sti.staged = nil
+			return nil
+		},
+		Discard: func() {
+			sti.staged = nil
+		},
+		Reset: func() error {
+			sti.isReset = true
+			return nil
+		},
+	}
+}
+
+func TestSync(t *testing.T) {
+	hashes := make([]*externalapi.DomainHash, 5)
+	for i := range hashes {
+		hashes[i] = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{byte(i)})
+	}
+	consensus := &syncTestConsensus{
+		virtualSelectedParent: hashes[4],
+		chainPaths: map[externalapi.DomainHash]*externalapi.SelectedChainPath{
+			*hashes[2]: {
+				Removed: []*externalapi.DomainHash{hashes[2]},
+				Added:   []*externalapi.DomainHash{hashes[3], hashes[4]},
+			},
+			*hashes[4]: {},
+		},
+	}
+
+	// An index that is already synced is left alone
+	syncedIndex := &syncTestIndex{virtualSelectedParent: hashes[4]}
+	err := Sync(consensus, syncedIndex.index())
+	if err != nil {
+		t.Fatalf("Sync: %s", err)
+	}
+	if syncedIndex.isReset || len(syncedIndex.committed) != 0 {
+		t.Fatalf("Expected a synced index to be left alone")
+	}
+
+	// An index that is behind catches up from its virtual selected parent
+	behindIndex := &syncTestIndex{virtualSelectedParent: hashes[2]}
+	err = Sync(consensus, behindIndex.index())
+	if err != nil {
+		t.Fatalf("Sync: %s", err)
+	}
+	expectedCommitted := []string{"-" + hashes[2].String(), "+" + hashes[3].String(), "+" + hashes[4].String()}
+	if behindIndex.isReset || !reflect.DeepEqual(behindIndex.committed, expectedCommitted) {
+		t.Fatalf("Expected the index to catch up with %v, but it was reset: %t, committed: %v",
+			expectedCommitted, behindIndex.isReset, behindIndex.committed)
+	}
+	if !behindIndex.virtualSelectedParent.Equal(hashes[4]) {
+		t.Fatalf("Expected the index to be synced to %s, got %s", hashes[4], behindIndex.virtualSelectedParent)
+	}
+
+	// An index that was never synced, or whose chain can't be resolved, is reset
+	for _, index := range []*syncTestIndex{{}, {virtualSelectedParent: hashes[1]}} {
+		err = Sync(consensus, index.index())
+		if err != nil {
+			t.Fatalf("Sync: %s", err)
+		}
+		if !index.isReset {
+			t.Fatalf("Expected an index synced to %s to be reset", index.virtualSelectedParent)
+		}
+	}
+}
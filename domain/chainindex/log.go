@@ -0,0 +1,7 @@
+package chainindex
+
+import (
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("CHIN")
@@ -246,6 +246,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.GenesisHash,
 		config.HalvingPhaseDaaScore,
 		config.HalvingPhaseBaseSubsidy,
+		config.CoinbasePayoutSplittingActivationDAAScore,
 
 		dagTraversalManager,
 		ghostdagDataStore,
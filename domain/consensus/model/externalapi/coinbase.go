@@ -7,6 +7,18 @@ import "bytes"
 type DomainCoinbaseData struct {
 	ScriptPublicKey *ScriptPublicKey
 	ExtraData       []byte
+
+	// Payouts, if not empty, splits the block rewards between several script public
+	// keys in proportion to their weights, instead of paying them all to ScriptPublicKey.
+	// In that case, ScriptPublicKey is expected to be that of the first payout
+	Payouts []*DomainCoinbasePayout
+}
+
+// DomainCoinbasePayout is a script public key that receives a share of the
+// block rewards, in proportion to its weight out of the weights of all payouts
+type DomainCoinbasePayout struct {
+	ScriptPublicKey *ScriptPublicKey
+	Weight          uint32
 }
 
 // Clone returns a clone of DomainCoinbaseData
@@ -18,9 +30,18 @@ func (dcd *DomainCoinbaseData) Clone() *DomainCoinbaseData {
 	extraDataClone := make([]byte, len(dcd.ExtraData))
 	copy(extraDataClone, dcd.ExtraData)
 
+	var payoutsClone []*DomainCoinbasePayout
+	if len(dcd.Payouts) > 0 {
+		payoutsClone = make([]*DomainCoinbasePayout, len(dcd.Payouts))
+		for i, payout := range dcd.Payouts {
+			payoutsClone[i] = payout.Clone()
+		}
+	}
+
 	return &DomainCoinbaseData{
 		ScriptPublicKey: &ScriptPublicKey{Script: scriptPubKeyClone, Version: dcd.ScriptPublicKey.Version},
 		ExtraData:       extraDataClone,
+		Payouts:         payoutsClone,
 	}
 }
 
@@ -34,5 +55,34 @@ func (dcd *DomainCoinbaseData) Equal(other *DomainCoinbaseData) bool {
 		return false
 	}
 
+	if len(dcd.Payouts) != len(other.Payouts) {
+		return false
+	}
+	for i, payout := range dcd.Payouts {
+		if !payout.Equal(other.Payouts[i]) {
+			return false
+		}
+	}
+
 	return dcd.ScriptPublicKey.Equal(other.ScriptPublicKey)
 }
+
+// Clone returns a clone of DomainCoinbasePayout
+func (dcp *DomainCoinbasePayout) Clone() *DomainCoinbasePayout {
+	scriptPubKeyClone := make([]byte, len(dcp.ScriptPublicKey.Script))
+	copy(scriptPubKeyClone, dcp.ScriptPublicKey.Script)
+
+	return &DomainCoinbasePayout{
+		ScriptPublicKey: &ScriptPublicKey{Script: scriptPubKeyClone, Version: dcp.ScriptPublicKey.Version},
+		Weight:          dcp.Weight,
+	}
+}
+
+// Equal returns whether dcp equals to other
+func (dcp *DomainCoinbasePayout) Equal(other *DomainCoinbasePayout) bool {
+	if dcp == nil || other == nil {
+		return dcp == other
+	}
+
+	return dcp.Weight == other.Weight && dcp.ScriptPublicKey.Equal(other.ScriptPublicKey)
+}
@@ -2,9 +2,11 @@ package coinbasemanager
 
 import (
 	"math"
+	"math/bits"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/model"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/ruleerrors"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/hashset"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
@@ -20,6 +22,7 @@ type coinbaseManager struct {
 	genesisHash                             *externalapi.DomainHash
 	halvingPhaseDaaScore                    uint64
 	halvingPhaseBaseSubsidy                 uint64
+	payoutSplittingActivationDAAScore       uint64
 
 	databaseContext     model.DBReader
 	dagTraversalManager model.DAGTraversalManager
@@ -52,6 +55,17 @@ func (c *coinbaseManager) ExpectedCoinbaseTransaction(stagingArea *model.Staging
 		return nil, false, err
 	}
 
+	if len(coinbaseData.Payouts) > 0 {
+		daaScore, err := c.daaBlocksStore.DAAScore(c.databaseContext, stagingArea, blockHash)
+		if err != nil {
+			return nil, false, err
+		}
+		if !c.isPayoutSplittingActive(daaScore) {
+			return nil, false, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase payout splitting "+
+				"is only active from DAA score %d", c.payoutSplittingActivationDAAScore)
+		}
+	}
+
 	daaAddedBlocksSet, err := c.daaAddedBlocksSet(stagingArea, blockHash)
 	if err != nil {
 		return nil, false, err
@@ -60,25 +74,21 @@ func (c *coinbaseManager) ExpectedCoinbaseTransaction(stagingArea *model.Staging
 	txOuts := make([]*externalapi.DomainTransactionOutput, 0, len(ghostdagData.MergeSetBlues()))
 	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
 	for _, blue := range ghostdagData.MergeSetBlues() {
-		txOut, hasReward, err := c.coinbaseOutputForBlueBlock(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
+		blueTxOuts, err := c.coinbaseOutputsForBlueBlock(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
 		if err != nil {
 			return nil, false, err
 		}
 
-		if hasReward {
-			txOuts = append(txOuts, txOut)
-		}
+		txOuts = append(txOuts, blueTxOuts...)
 	}
 
-	txOut, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocks(
+	redTxOuts, hasRedReward, err := c.coinbaseOutputsForRewardFromRedBlocks(
 		stagingArea, ghostdagData, acceptanceData, daaAddedBlocksSet, coinbaseData)
 	if err != nil {
 		return nil, false, err
 	}
 
-	if hasRedReward {
-		txOuts = append(txOuts, txOut)
-	}
+	txOuts = append(txOuts, redTxOuts...)
 
 	subsidy, err := c.CalcBlockSubsidy(stagingArea, blockHash)
 	if err != nil {
@@ -112,38 +122,39 @@ func (c *coinbaseManager) daaAddedBlocksSet(stagingArea *model.StagingArea, bloc
 	return hashset.NewFromSlice(daaAddedBlocks...), nil
 }
 
-// coinbaseOutputForBlueBlock calculates the output that should go into the coinbase transaction of blueBlock
-// If blueBlock gets no fee - returns nil for txOut
-func (c *coinbaseManager) coinbaseOutputForBlueBlock(stagingArea *model.StagingArea,
+// coinbaseOutputsForBlueBlock calculates the outputs that should go into the coinbase transaction of blueBlock
+// If blueBlock gets no fee - returns no outputs
+func (c *coinbaseManager) coinbaseOutputsForBlueBlock(stagingArea *model.StagingArea,
 	blueBlock *externalapi.DomainHash, blockAcceptanceData *externalapi.BlockAcceptanceData,
-	mergingBlockDAAAddedBlocksSet hashset.HashSet) (*externalapi.DomainTransactionOutput, bool, error) {
+	mergingBlockDAAAddedBlocksSet hashset.HashSet) ([]*externalapi.DomainTransactionOutput, error) {
 
 	blockReward, err := c.calcMergedBlockReward(stagingArea, blueBlock, blockAcceptanceData, mergingBlockDAAAddedBlocksSet)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
 	if blockReward == 0 {
-		return nil, false, nil
+		return nil, nil
 	}
 
-	// the ScriptPublicKey for the coinbase is parsed from the coinbase payload
-	_, coinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(blockAcceptanceData.TransactionAcceptanceData[0].Transaction)
+	blueBlockHeader, err := c.blockHeaderStore.BlockHeader(c.databaseContext, stagingArea, blueBlock)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           blockReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
+	// the ScriptPublicKey for the coinbase is parsed from the coinbase payload
+	_, coinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(
+		blockAcceptanceData.TransactionAcceptanceData[0].Transaction, blueBlockHeader.DAAScore())
+	if err != nil {
+		return nil, err
 	}
 
-	return txOut, true, nil
+	return coinbaseOutputs(blockReward, coinbaseData), nil
 }
 
-func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocks(stagingArea *model.StagingArea,
+func (c *coinbaseManager) coinbaseOutputsForRewardFromRedBlocks(stagingArea *model.StagingArea,
 	ghostdagData *externalapi.BlockGHOSTDAGData, acceptanceData externalapi.AcceptanceData, daaAddedBlocksSet hashset.HashSet,
-	coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainTransactionOutput, bool, error) {
+	coinbaseData *externalapi.DomainCoinbaseData) ([]*externalapi.DomainTransactionOutput, bool, error) {
 
 	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
 	totalReward := uint64(0)
@@ -160,10 +171,52 @@ func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocks(stagingArea *mode
 		return nil, false, nil
 	}
 
-	return &externalapi.DomainTransactionOutput{
-		Value:           totalReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
-	}, true, nil
+	return coinbaseOutputs(totalReward, coinbaseData), true, nil
+}
+
+// coinbaseOutputs returns the outputs that pay the given reward to the given coinbase data. If it
+// has payouts, the reward is split between them in proportion to their weights, rounding down,
+// with whatever is left from the rounding going to the first payout. Payouts whose share
+// rounds down to zero get no output.
+func coinbaseOutputs(reward uint64, coinbaseData *externalapi.DomainCoinbaseData) []*externalapi.DomainTransactionOutput {
+	if len(coinbaseData.Payouts) == 0 {
+		return []*externalapi.DomainTransactionOutput{{
+			Value:           reward,
+			ScriptPublicKey: coinbaseData.ScriptPublicKey,
+		}}
+	}
+
+	totalWeight := uint64(0)
+	for _, payout := range coinbaseData.Payouts {
+		totalWeight += uint64(payout.Weight)
+	}
+
+	values := make([]uint64, len(coinbaseData.Payouts))
+	totalValue := uint64(0)
+	for i, payout := range coinbaseData.Payouts {
+		// reward*weight can overflow 64 bits, but reward*weight/totalWeight can't,
+		// since weight is at most totalWeight
+		high, low := bits.Mul64(reward, uint64(payout.Weight))
+		values[i], _ = bits.Div64(high, low, totalWeight)
+		totalValue += values[i]
+	}
+	values[0] += reward - totalValue
+
+	txOuts := make([]*externalapi.DomainTransactionOutput, 0, len(coinbaseData.Payouts))
+	for i, payout := range coinbaseData.Payouts {
+		if values[i] == 0 {
+			continue
+		}
+		txOuts = append(txOuts, &externalapi.DomainTransactionOutput{
+			Value:           values[i],
+			ScriptPublicKey: payout.ScriptPublicKey,
+		})
+	}
+	return txOuts
+}
+
+func (c *coinbaseManager) isPayoutSplittingActive(daaScore uint64) bool {
+	return daaScore >= c.payoutSplittingActivationDAAScore
 }
 
 func acceptanceDataFromArrayToMap(acceptanceData externalapi.AcceptanceData) map[externalapi.DomainHash]*externalapi.BlockAcceptanceData {
@@ -267,7 +320,8 @@ func (c *coinbaseManager) calcMergedBlockReward(stagingArea *model.StagingArea,
 		return 0, err
 	}
 
-	_, _, subsidy, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(block.Transactions[transactionhelper.CoinbaseTransactionIndex])
+	_, _, subsidy, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(
+		block.Transactions[transactionhelper.CoinbaseTransactionIndex], block.Header.DAAScore())
 	if err != nil {
 		return 0, err
 	}
@@ -285,6 +339,7 @@ func New(
 	genesisHash *externalapi.DomainHash,
 	halvingPhaseDaaScore uint64,
 	halvingPhaseBaseSubsidy uint64,
+	payoutSplittingActivationDAAScore uint64,
 
 	dagTraversalManager model.DAGTraversalManager,
 	ghostdagDataStore model.GHOSTDAGDataStore,
@@ -303,6 +358,7 @@ func New(
 		genesisHash:                             genesisHash,
 		halvingPhaseDaaScore:                    halvingPhaseDaaScore,
 		halvingPhaseBaseSubsidy:                 halvingPhaseBaseSubsidy,
+		payoutSplittingActivationDAAScore:       payoutSplittingActivationDAAScore,
 
 		dagTraversalManager: dagTraversalManager,
 		ghostdagDataStore:   ghostdagDataStore,
@@ -1,12 +1,21 @@
 package coinbasemanager_test
 
 import (
+	"bytes"
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/shatll-s/nexelliad/domain/consensus"
 	"github.com/shatll-s/nexelliad/domain/consensus/model"
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/processes/coinbasemanager"
+	"github.com/shatll-s/nexelliad/domain/consensus/ruleerrors"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/testutils"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionhelper"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
+	"github.com/shatll-s/nexelliad/util"
+	"github.com/shatll-s/nexelliad/version"
 )
 
 func TestExtractCoinbaseDataBlueScoreAndSubsidy(t *testing.T) {
@@ -44,7 +53,7 @@ func TestExtractCoinbaseDataBlueScoreAndSubsidy(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			_, cbData, _, err := tc.CoinbaseManager().ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx)
+			_, cbData, _, err := tc.CoinbaseManager().ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx, 0)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -56,3 +65,186 @@ func TestExtractCoinbaseDataBlueScoreAndSubsidy(t *testing.T) {
 
 	})
 }
+
+func TestCoinbasePayoutSplitting(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.CoinbasePayoutSplittingActivationDAAScore = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestCoinbasePayoutSplitting")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		payouts := []*externalapi.DomainCoinbasePayout{
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}, Weight: 3},
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{4, 5, 6}, Version: 0}, Weight: 1},
+		}
+		splitCoinbaseData := &externalapi.DomainCoinbaseData{
+			ScriptPublicKey: payouts[0].ScriptPublicKey,
+			ExtraData:       []byte{7, 8, 9},
+			Payouts:         payouts,
+		}
+
+		splitBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, splitCoinbaseData, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		splitBlock, _, err := tc.GetBlock(splitBlockHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+
+		_, extractedCoinbaseData, subsidy, err := tc.CoinbaseManager().ExtractCoinbaseDataBlueScoreAndSubsidy(
+			splitBlock.Transactions[transactionhelper.CoinbaseTransactionIndex], splitBlock.Header.DAAScore())
+		if err != nil {
+			t.Fatalf("ExtractCoinbaseDataBlueScoreAndSubsidy: %+v", err)
+		}
+		if !extractedCoinbaseData.Equal(splitCoinbaseData) {
+			t.Fatalf("Expected the extracted coinbase data to equal the one the block was built with")
+		}
+
+		// The rewards of the split block are paid by the coinbase of the block that merges it
+		mergingBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{splitBlockHash}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		mergingBlock, _, err := tc.GetBlock(mergingBlockHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		outputs := mergingBlock.Transactions[transactionhelper.CoinbaseTransactionIndex].Outputs
+		if len(outputs) != len(payouts) {
+			t.Fatalf("Expected %d coinbase outputs but got %d", len(payouts), len(outputs))
+		}
+		expectedValues := []uint64{subsidy - subsidy/4, subsidy / 4}
+		for i, output := range outputs {
+			if !output.ScriptPublicKey.Equal(payouts[i].ScriptPublicKey) {
+				t.Fatalf("Expected output %d to pay to the script public key of payout %d", i, i)
+			}
+			if output.Value != expectedValues[i] {
+				t.Fatalf("Expected output %d to have value %d but got %d", i, expectedValues[i], output.Value)
+			}
+		}
+	})
+}
+
+func TestCoinbasePayoutSplittingBeforeActivation(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.CoinbasePayoutSplittingActivationDAAScore = math.MaxUint64
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestCoinbasePayoutSplittingBeforeActivation")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+		_, _, err = tc.CoinbaseManager().ExpectedCoinbaseTransaction(model.NewStagingArea(), model.VirtualBlockHash,
+			&externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKey,
+				Payouts: []*externalapi.DomainCoinbasePayout{
+					{ScriptPublicKey: scriptPublicKey, Weight: 1},
+				},
+			})
+		if !errors.Is(err, ruleerrors.ErrBadCoinbasePayouts) {
+			t.Fatalf("Expected ErrBadCoinbasePayouts before activation, but got: %+v", err)
+		}
+
+		// Before activation, a payload that looks like a split payload is
+		// parsed as a script public key with the marker as its version
+		coinbaseTx, _, err := tc.CoinbaseManager().ExpectedCoinbaseTransaction(model.NewStagingArea(), model.VirtualBlockHash,
+			&externalapi.DomainCoinbaseData{
+				ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: math.MaxUint16},
+			})
+		if err != nil {
+			t.Fatalf("ExpectedCoinbaseTransaction: %+v", err)
+		}
+		_, coinbaseData, _, err := tc.CoinbaseManager().ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx, 0)
+		if err != nil {
+			t.Fatalf("ExtractCoinbaseDataBlueScoreAndSubsidy: %+v", err)
+		}
+		if len(coinbaseData.Payouts) != 0 || coinbaseData.ScriptPublicKey.Version != math.MaxUint16 {
+			t.Fatalf("Expected the payload to be parsed as a single script public key before activation")
+		}
+	})
+}
+
+func TestCoinbasePayoutSplittingPayloadLength(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.CoinbasePayoutSplittingActivationDAAScore = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestCoinbasePayoutSplittingPayloadLength")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// Real pay-to-pubkey scripts are 34 bytes long for Schnorr keys and 35 bytes long for ECDSA keys
+		var payouts []*externalapi.DomainCoinbasePayout
+		for i := 0; i < 5; i++ {
+			var address util.Address
+			if i%2 == 0 {
+				address, err = util.NewAddressPublicKey(bytes.Repeat([]byte{byte(i + 1)}, util.PublicKeySize),
+					consensusConfig.Prefix)
+			} else {
+				address, err = util.NewAddressPublicKeyECDSA(bytes.Repeat([]byte{byte(i + 1)}, util.PublicKeySizeECDSA),
+					consensusConfig.Prefix)
+			}
+			if err != nil {
+				t.Fatalf("Failed to generate a p2pk address: %+v", err)
+			}
+			scriptPublicKey, err := txscript.PayToAddrScript(address)
+			if err != nil {
+				t.Fatalf("PayToAddrScript: %+v", err)
+			}
+			payouts = append(payouts, &externalapi.DomainCoinbasePayout{ScriptPublicKey: scriptPublicKey, Weight: 1})
+		}
+		coinbaseDataWithPayouts := func(numberOfPayouts int) *externalapi.DomainCoinbaseData {
+			return &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: payouts[0].ScriptPublicKey,
+				ExtraData:       []byte(version.Version() + "/"),
+				Payouts:         payouts[:numberOfPayouts],
+			}
+		}
+		serializedPayloadLength := func(coinbaseData *externalapi.DomainCoinbaseData) uint64 {
+			payload, err := coinbasemanager.SerializeCoinbasePayload(0, coinbaseData, 0,
+				consensusConfig.CoinbasePayloadScriptPublicKeyMaxLength)
+			if err != nil {
+				t.Fatalf("SerializeCoinbasePayload: %+v", err)
+			}
+			return uint64(len(payload))
+		}
+
+		fittingCoinbaseData := coinbaseDataWithPayouts(4)
+		fittingPayloadLength := serializedPayloadLength(fittingCoinbaseData)
+		if fittingPayloadLength > consensusConfig.MaxCoinbasePayloadLength {
+			t.Fatalf("Expected a payload with 4 payouts to fit in %d bytes, but it is %d bytes long",
+				consensusConfig.MaxCoinbasePayloadLength, fittingPayloadLength)
+		}
+		blockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, fittingCoinbaseData, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		block, _, err := tc.GetBlock(blockHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		blockPayloadLength := uint64(len(block.Transactions[transactionhelper.CoinbaseTransactionIndex].Payload))
+		if blockPayloadLength != fittingPayloadLength {
+			t.Fatalf("Expected the block's coinbase payload to be %d bytes long, but it is %d bytes long",
+				fittingPayloadLength, blockPayloadLength)
+		}
+
+		tooLongCoinbaseData := coinbaseDataWithPayouts(5)
+		tooLongPayloadLength := serializedPayloadLength(tooLongCoinbaseData)
+		if tooLongPayloadLength <= consensusConfig.MaxCoinbasePayloadLength {
+			t.Fatalf("Expected a payload with 5 payouts to be longer than %d bytes, but it is %d bytes long",
+				consensusConfig.MaxCoinbasePayloadLength, tooLongPayloadLength)
+		}
+		_, _, err = tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, tooLongCoinbaseData, nil)
+		if !errors.Is(err, ruleerrors.ErrBadCoinbasePayloadLen) {
+			t.Fatalf("Expected ErrBadCoinbasePayloadLen for a payload longer than the max, but got: %+v", err)
+		}
+	})
+}
@@ -22,6 +22,7 @@ func TestCalcHalvingPeriodBlockSubsidy(t *testing.T) {
 		&externalapi.DomainHash{},
 		halvingPhaseDaaScore,
 		halvingPhaseBaseSubsidy,
+		0,
 		nil,
 		nil,
 		nil,
@@ -97,6 +98,7 @@ func TestBuildSubsidyTable(t *testing.T) {
 		&externalapi.DomainHash{},
 		0,
 		halvingPhaseBaseSubsidy,
+		0,
 		nil,
 		nil,
 		nil,
@@ -125,3 +127,92 @@ func TestBuildSubsidyTable(t *testing.T) {
 	tableStr += "\n}"
 	t.Logf(tableStr)
 }
+
+func TestCoinbaseOutputs(t *testing.T) {
+	scriptPublicKeyA := &externalapi.ScriptPublicKey{Script: []byte{1}, Version: 0}
+	scriptPublicKeyB := &externalapi.ScriptPublicKey{Script: []byte{2}, Version: 0}
+	scriptPublicKeyC := &externalapi.ScriptPublicKey{Script: []byte{3}, Version: 0}
+
+	tests := []struct {
+		name           string
+		reward         uint64
+		coinbaseData   *externalapi.DomainCoinbaseData
+		expectedValues []uint64
+	}{
+		{
+			name:           "no payouts",
+			reward:         100,
+			coinbaseData:   &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKeyA},
+			expectedValues: []uint64{100},
+		},
+		{
+			name:   "even split",
+			reward: 100,
+			coinbaseData: &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKeyA,
+				Payouts: []*externalapi.DomainCoinbasePayout{
+					{ScriptPublicKey: scriptPublicKeyA, Weight: 1},
+					{ScriptPublicKey: scriptPublicKeyB, Weight: 1},
+				},
+			},
+			expectedValues: []uint64{50, 50},
+		},
+		{
+			name:   "remainder goes to the first payout",
+			reward: 100,
+			coinbaseData: &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKeyA,
+				Payouts: []*externalapi.DomainCoinbasePayout{
+					{ScriptPublicKey: scriptPublicKeyA, Weight: 1},
+					{ScriptPublicKey: scriptPublicKeyB, Weight: 1},
+					{ScriptPublicKey: scriptPublicKeyC, Weight: 1},
+				},
+			},
+			expectedValues: []uint64{34, 33, 33},
+		},
+		{
+			name:   "share rounding down to zero gets no output",
+			reward: 10,
+			coinbaseData: &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKeyA,
+				Payouts: []*externalapi.DomainCoinbasePayout{
+					{ScriptPublicKey: scriptPublicKeyA, Weight: 100},
+					{ScriptPublicKey: scriptPublicKeyB, Weight: 1},
+				},
+			},
+			expectedValues: []uint64{10},
+		},
+		{
+			name:   "large reward and weights don't overflow",
+			reward: constants.MaxSompi,
+			coinbaseData: &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKeyA,
+				Payouts: []*externalapi.DomainCoinbasePayout{
+					{ScriptPublicKey: scriptPublicKeyA, Weight: 1<<32 - 1},
+					{ScriptPublicKey: scriptPublicKeyB, Weight: 1<<32 - 1},
+				},
+			},
+			expectedValues: []uint64{constants.MaxSompi - constants.MaxSompi/2, constants.MaxSompi / 2},
+		},
+	}
+
+	for _, test := range tests {
+		outputs := coinbaseOutputs(test.reward, test.coinbaseData)
+		if len(outputs) != len(test.expectedValues) {
+			t.Fatalf("TestCoinbaseOutputs: test '%s' failed. Want %d outputs, got: %d",
+				test.name, len(test.expectedValues), len(outputs))
+		}
+		totalValue := uint64(0)
+		for i, output := range outputs {
+			if output.Value != test.expectedValues[i] {
+				t.Errorf("TestCoinbaseOutputs: test '%s' failed. Want output %d to have value %d, got: %d",
+					test.name, i, test.expectedValues[i], output.Value)
+			}
+			totalValue += output.Value
+		}
+		if totalValue != test.reward {
+			t.Errorf("TestCoinbaseOutputs: test '%s' failed. Want a total value of %d, got: %d",
+				test.name, test.reward, totalValue)
+		}
+	}
+}
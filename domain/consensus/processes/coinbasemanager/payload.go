@@ -2,6 +2,7 @@ package coinbasemanager
 
 import (
 	"encoding/binary"
+	"math"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/ruleerrors"
@@ -9,19 +10,40 @@ import (
 )
 
 const uint64Len = 8
+const uint32Len = 4
 const uint16Len = 2
 const lengthOfSubsidy = uint64Len
 const lengthOfScriptPubKeyLength = 1
 const lengthOfVersionScriptPubKey = uint16Len
+const lengthOfNumberOfPayouts = 1
+const lengthOfPayoutWeight = uint32Len
+
+// splitPayoutsMarker takes the place of the script public key version in payloads that split
+// the rewards between several payouts. It only has that meaning once coinbase payout splitting
+// is active, and is then followed by the number of payouts, and then by each payout's weight,
+// script public key version, script length and script.
+const splitPayoutsMarker = math.MaxUint16
 
 // serializeCoinbasePayload builds the coinbase payload based on the provided scriptPubKey and extra data.
 func (c *coinbaseManager) serializeCoinbasePayload(blueScore uint64,
 	coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64) ([]byte, error) {
 
+	return SerializeCoinbasePayload(blueScore, coinbaseData, subsidy, c.coinbasePayloadScriptPublicKeyMaxLength)
+}
+
+// SerializeCoinbasePayload builds the coinbase payload based on the provided scriptPubKey or payouts, and extra data.
+// It lets callers outside consensus find the length of the payload that the coinbase data results in.
+func SerializeCoinbasePayload(blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64,
+	coinbasePayloadScriptPublicKeyMaxLength uint8) ([]byte, error) {
+
+	if len(coinbaseData.Payouts) > 0 {
+		return serializeSplitCoinbasePayload(blueScore, coinbaseData, subsidy, coinbasePayloadScriptPublicKeyMaxLength)
+	}
+
 	scriptLengthOfScriptPubKey := len(coinbaseData.ScriptPublicKey.Script)
-	if scriptLengthOfScriptPubKey > int(c.coinbasePayloadScriptPublicKeyMaxLength) {
+	if scriptLengthOfScriptPubKey > int(coinbasePayloadScriptPublicKeyMaxLength) {
 		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
-			"longer than the max allowed length of %d", c.coinbasePayloadScriptPublicKeyMaxLength)
+			"longer than the max allowed length of %d", coinbasePayloadScriptPublicKeyMaxLength)
 	}
 
 	payload := make([]byte, uint64Len+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptLengthOfScriptPubKey+len(coinbaseData.ExtraData)+lengthOfSubsidy)
@@ -36,9 +58,61 @@ func (c *coinbaseManager) serializeCoinbasePayload(blueScore uint64,
 	return payload, nil
 }
 
+// serializeSplitCoinbasePayload builds the coinbase payload of a block that splits its
+// rewards between the payouts in the provided coinbase data.
+func serializeSplitCoinbasePayload(blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64,
+	coinbasePayloadScriptPublicKeyMaxLength uint8) ([]byte, error) {
+
+	if len(coinbaseData.Payouts) > math.MaxUint8 {
+		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase has %d payouts, while the "+
+			"max allowed is %d", len(coinbaseData.Payouts), math.MaxUint8)
+	}
+
+	payloadLength := uint64Len + lengthOfSubsidy + lengthOfVersionScriptPubKey + lengthOfNumberOfPayouts + len(coinbaseData.ExtraData)
+	for _, payout := range coinbaseData.Payouts {
+		if len(payout.ScriptPublicKey.Script) > int(coinbasePayloadScriptPublicKeyMaxLength) {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
+				"longer than the max allowed length of %d", coinbasePayloadScriptPublicKeyMaxLength)
+		}
+		if payout.Weight == 0 {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase payout weights must be positive")
+		}
+		if payout.ScriptPublicKey.Version == splitPayoutsMarker {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase payout script public key "+
+				"version %d is reserved", splitPayoutsMarker)
+		}
+		payloadLength += lengthOfPayoutWeight + lengthOfVersionScriptPubKey + lengthOfScriptPubKeyLength + len(payout.ScriptPublicKey.Script)
+	}
+
+	payload := make([]byte, payloadLength)
+	binary.LittleEndian.PutUint64(payload[:uint64Len], blueScore)
+	binary.LittleEndian.PutUint64(payload[uint64Len:], subsidy)
+	binary.LittleEndian.PutUint16(payload[uint64Len+lengthOfSubsidy:], splitPayoutsMarker)
+	payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey] = uint8(len(coinbaseData.Payouts))
+
+	offset := uint64Len + lengthOfSubsidy + lengthOfVersionScriptPubKey + lengthOfNumberOfPayouts
+	for _, payout := range coinbaseData.Payouts {
+		binary.LittleEndian.PutUint32(payload[offset:], payout.Weight)
+		offset += lengthOfPayoutWeight
+		binary.LittleEndian.PutUint16(payload[offset:], payout.ScriptPublicKey.Version)
+		offset += lengthOfVersionScriptPubKey
+		payload[offset] = uint8(len(payout.ScriptPublicKey.Script))
+		offset += lengthOfScriptPubKeyLength
+		offset += copy(payload[offset:], payout.ScriptPublicKey.Script)
+	}
+	copy(payload[offset:], coinbaseData.ExtraData)
+
+	return payload, nil
+}
+
 // ModifyCoinbasePayload modifies the coinbase payload based on the provided scriptPubKey and extra data.
+// It does not support coinbase data with payouts, since splitting the rewards changes the coinbase outputs too.
 func ModifyCoinbasePayload(payload []byte, coinbaseData *externalapi.DomainCoinbaseData, coinbasePayloadScriptPublicKeyMaxLength uint8) ([]byte, error) {
 
+	if len(coinbaseData.Payouts) > 0 {
+		return nil, errors.New("cannot modify a coinbase payload to split the rewards between payouts")
+	}
+
 	scriptLengthOfScriptPubKey := len(coinbaseData.ScriptPublicKey.Script)
 	if scriptLengthOfScriptPubKey > int(coinbasePayloadScriptPublicKeyMaxLength) {
 		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
@@ -61,7 +135,9 @@ func ModifyCoinbasePayload(payload []byte, coinbaseData *externalapi.DomainCoinb
 }
 
 // ExtractCoinbaseDataBlueScoreAndSubsidy deserializes the coinbase payload to its component (scriptPubKey, extra data, and subsidy).
-func (c *coinbaseManager) ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *externalapi.DomainTransaction) (
+// daaScore is the DAA score of the block the coinbase transaction belongs to, which decides whether
+// the payload may split the rewards between several payouts.
+func (c *coinbaseManager) ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *externalapi.DomainTransaction, daaScore uint64) (
 	blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64, err error) {
 
 	minLength := uint64Len + lengthOfSubsidy + lengthOfVersionScriptPubKey + lengthOfScriptPubKeyLength
@@ -75,6 +151,14 @@ func (c *coinbaseManager) ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *ext
 
 	scriptPubKeyVersion := binary.LittleEndian.Uint16(coinbaseTx.Payload[uint64Len+lengthOfSubsidy : uint64Len+lengthOfSubsidy+uint16Len])
 
+	if scriptPubKeyVersion == splitPayoutsMarker && c.isPayoutSplittingActive(daaScore) {
+		coinbaseData, err := c.extractSplitCoinbaseData(coinbaseTx.Payload)
+		if err != nil {
+			return 0, nil, 0, err
+		}
+		return blueScore, coinbaseData, subsidy, nil
+	}
+
 	scriptPubKeyScriptLength := coinbaseTx.Payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey]
 
 	if scriptPubKeyScriptLength > c.coinbasePayloadScriptPublicKeyMaxLength {
@@ -93,3 +177,53 @@ func (c *coinbaseManager) ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *ext
 		ExtraData:       coinbaseTx.Payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptPubKeyScriptLength:],
 	}, subsidy, nil
 }
+
+// extractSplitCoinbaseData deserializes the payouts and extra data of a payload
+// that starts with splitPayoutsMarker in place of the script public key version.
+func (c *coinbaseManager) extractSplitCoinbaseData(payload []byte) (*externalapi.DomainCoinbaseData, error) {
+	offset := uint64Len + lengthOfSubsidy + lengthOfVersionScriptPubKey
+	numberOfPayouts := int(payload[offset])
+	offset += lengthOfNumberOfPayouts
+	if numberOfPayouts == 0 {
+		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase payload splits the rewards between no payouts")
+	}
+
+	payouts := make([]*externalapi.DomainCoinbasePayout, numberOfPayouts)
+	for i := range payouts {
+		if len(payload) < offset+lengthOfPayoutWeight+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+				"coinbase payload doesn't have enough bytes to contain %d payouts", numberOfPayouts)
+		}
+		weight := binary.LittleEndian.Uint32(payload[offset:])
+		offset += lengthOfPayoutWeight
+		if weight == 0 {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayouts, "coinbase payout weights must be positive")
+		}
+		scriptPubKeyVersion := binary.LittleEndian.Uint16(payload[offset:])
+		offset += lengthOfVersionScriptPubKey
+		scriptPubKeyScriptLength := payload[offset]
+		offset += lengthOfScriptPubKeyLength
+
+		if scriptPubKeyScriptLength > c.coinbasePayloadScriptPublicKeyMaxLength {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
+				"longer than the max allowed length of %d", c.coinbasePayloadScriptPublicKeyMaxLength)
+		}
+		if len(payload) < offset+int(scriptPubKeyScriptLength) {
+			return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+				"coinbase payload doesn't have enough bytes to contain a script public key of %d bytes", scriptPubKeyScriptLength)
+		}
+		scriptPubKeyScript := payload[offset : offset+int(scriptPubKeyScriptLength)]
+		offset += int(scriptPubKeyScriptLength)
+
+		payouts[i] = &externalapi.DomainCoinbasePayout{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: scriptPubKeyScript, Version: scriptPubKeyVersion},
+			Weight:          weight,
+		}
+	}
+
+	return &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: payouts[0].ScriptPublicKey,
+		ExtraData:       payload[offset:],
+		Payouts:         payouts,
+	}, nil
+}
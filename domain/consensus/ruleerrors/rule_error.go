@@ -133,6 +133,10 @@ var (
 	// ErrBadCoinbaseTransaction indicates that the block's coinbase transaction is not build as expected
 	ErrBadCoinbaseTransaction = newRuleError("ErrBadCoinbaseTransaction")
 
+	// ErrBadCoinbasePayouts indicates that the payouts in the payload of a coinbase transaction
+	// are malformed, or that they split the rewards before coinbase payout splitting is active
+	ErrBadCoinbasePayouts = newRuleError("ErrBadCoinbasePayouts")
+
 	// ErrScriptMalformed indicates a transaction script is malformed in
 	// some way. For example, it might be longer than the maximum allowed
 	// length or fail to parse.
@@ -18,6 +18,7 @@ const (
 	proofOfWorkDomain             = "ProofOfWorkHash"
 	heavyHashDomain               = "HeavyHash"
 	merkleBranchDomain            = "MerkleBranchHash"
+	blockTemplateIDDomain         = "BlockTemplateID"
 )
 
 // transactionSigningECDSADomainHash is a hashed version of transcationSigningECDSADomain that is used
@@ -68,6 +69,15 @@ func NewBlockHashWriter() HashWriter {
 	return HashWriter{blake}
 }
 
+// NewBlockTemplateIDWriter Returns a new HashWriter used for block template IDs
+func NewBlockTemplateIDWriter() HashWriter {
+	blake, err := blake2b.New256([]byte(blockTemplateIDDomain))
+	if err != nil {
+		panic(errors.Wrapf(err, "this should never happen. %s is less than 64 bytes", blockTemplateIDDomain))
+	}
+	return HashWriter{blake}
+}
+
 // NewPoWHashWriter Returns a new HashWriter used for the PoW function
 func NewPoWHashWriter() Blake3HashWriter {
 	blake := blake3.New(32, nil)
@@ -1,14 +1,12 @@
 package pow
 
 import (
-	"math"
+	"math/big"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/hashes"
 )
 
-const eps float64 = 1e-9
-
 type matrix [64][64]uint16
 
 func generateMatrix(hash *externalapi.DomainHash) *matrix {
@@ -29,36 +27,124 @@ func generateMatrix(hash *externalapi.DomainHash) *matrix {
 	}
 }
 
+// computeRank returns the rank of the matrix over the rationals. It's computed
+// with exact integer arithmetic, so unlike floating point elimination its result
+// doesn't depend on rounding.
 func (mat *matrix) computeRank() int {
-	var B [64][64]float64
+	// A matrix that has full rank modulo a prime has full rank over the rationals,
+	// since its determinant is non-zero modulo that prime. Almost all matrices
+	// are settled this way. The rest are either singular or have a determinant
+	// that's a multiple of the prime, and need the exact computation
+	if mat.computeRankModPrime() == 64 {
+		return 64
+	}
+	return mat.computeRankExact()
+}
+
+// rankPrime is the Mersenne prime 2^31-1. Products of two numbers
+// modulo it fit in a uint64
+const rankPrime = 1<<31 - 1
+
+// computeRankModPrime returns the rank of the matrix over the integers modulo rankPrime
+func (mat *matrix) computeRankModPrime() int {
+	var B [64][64]uint64
 	for i := range B {
-		for j := range B[0] {
-			B[i][j] = float64(mat[i][j])
+		for j := range B[i] {
+			B[i][j] = uint64(mat[i][j])
 		}
 	}
-	var rank int
-	var rowSelected [64]bool
-	for i := 0; i < 64; i++ {
-		var j int
-		for j = 0; j < 64; j++ {
-			if !rowSelected[j] && math.Abs(B[j][i]) > eps {
+	rank := 0
+	for col := 0; col < 64; col++ {
+		pivotRow := -1
+		for row := rank; row < 64; row++ {
+			if B[row][col] != 0 {
+				pivotRow = row
 				break
 			}
 		}
-		if j != 64 {
-			rank++
-			rowSelected[j] = true
-			for p := i + 1; p < 64; p++ {
-				B[j][p] /= B[j][i]
+		if pivotRow == -1 {
+			continue
+		}
+		B[rank], B[pivotRow] = B[pivotRow], B[rank]
+
+		pivotInverse := modPrimeInverse(B[rank][col])
+		for row := rank + 1; row < 64; row++ {
+			if B[row][col] == 0 {
+				continue
 			}
-			for k := 0; k < 64; k++ {
-				if k != j && math.Abs(B[k][i]) > eps {
-					for p := i + 1; p < 64; p++ {
-						B[k][p] -= B[j][p] * B[k][i]
-					}
-				}
+			factor := reduceModPrime(B[row][col] * pivotInverse)
+			for p := col; p < 64; p++ {
+				B[row][p] = reduceModPrime(B[row][p] + (rankPrime-factor)*B[rank][p])
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+// reduceModPrime returns x modulo rankPrime for any x below 2^63. Since rankPrime
+// is 2^31-1, this only takes folding the high bits of x onto its low bits
+func reduceModPrime(x uint64) uint64 {
+	x = (x & rankPrime) + (x >> 31)
+	x = (x & rankPrime) + (x >> 31)
+	if x >= rankPrime {
+		x -= rankPrime
+	}
+	return x
+}
+
+// modPrimeInverse returns the inverse of a non-zero x modulo rankPrime,
+// which by Fermat's little theorem is x^(rankPrime-2)
+func modPrimeInverse(x uint64) uint64 {
+	result := uint64(1)
+	for exponent := uint64(rankPrime - 2); exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = reduceModPrime(result * x)
+		}
+		x = reduceModPrime(x * x)
+	}
+	return result
+}
+
+// computeRankExact returns the rank of the matrix over the rationals using fraction-free
+// (Bareiss) elimination. Every division in it is exact, since every entry is a minor
+// of the original matrix
+func (mat *matrix) computeRankExact() int {
+	var B [64][64]*big.Int
+	for i := range B {
+		for j := range B[i] {
+			B[i][j] = big.NewInt(int64(mat[i][j]))
+		}
+	}
+	rank := 0
+	previousPivot := big.NewInt(1)
+	product := new(big.Int)
+	for col := 0; col < 64; col++ {
+		pivotRow := -1
+		for row := rank; row < 64; row++ {
+			if B[row][col].Sign() != 0 {
+				pivotRow = row
+				break
+			}
+		}
+		if pivotRow == -1 {
+			continue
+		}
+		B[rank], B[pivotRow] = B[pivotRow], B[rank]
+
+		pivot := B[rank][col]
+		for row := rank + 1; row < 64; row++ {
+			for p := col + 1; p < 64; p++ {
+				// B[row][p] = (pivot*B[row][p] - B[row][col]*B[rank][p]) / previousPivot
+				B[row][p].Mul(B[row][p], pivot)
+				product.Mul(B[row][col], B[rank][p])
+				B[row][p].Sub(B[row][p], product)
+				B[row][p].Quo(B[row][p], previousPivot)
 			}
+			B[row][col].SetInt64(0)
 		}
+		previousPivot = pivot
+		rank++
 	}
 	return rank
 }
@@ -90,3 +176,47 @@ func (mat *matrix) HeavyHash(hash *externalapi.DomainHash) *externalapi.DomainHa
 	writer.InfallibleWrite(res[:])
 	return writer.Finalize()
 }
+
+// packedMatrix holds the columns of a matrix, with four consecutive rows packed into
+// every uint64 at 16 bits per row. This lets HeavyHash multiply four rows at once
+// with plain integer arithmetic. No lane ever carries into the next one, since a
+// row's sum is at most 64*15*15, which fits in 16 bits.
+type packedMatrix [64][16]uint64
+
+func (mat *matrix) pack() *packedMatrix {
+	var packed packedMatrix
+	for i := range mat {
+		for j := range mat[i] {
+			packed[j][i/4] |= uint64(mat[i][j]) << (16 * (i % 4))
+		}
+	}
+	return &packed
+}
+
+// HeavyHash returns the same result as matrix.HeavyHash for the matrix it was packed from
+func (mat *packedMatrix) HeavyHash(hash *externalapi.DomainHash) *externalapi.DomainHash {
+	hashBytes := hash.ByteArray()
+	var sums [16]uint64
+	// Every byte of the hash holds the vector elements of two consecutive columns
+	for i, hashByte := range hashBytes {
+		highNibble, lowNibble := uint64(hashByte>>4), uint64(hashByte&0x0F)
+		highColumn, lowColumn := &mat[2*i], &mat[2*i+1]
+		for k := range sums {
+			sums[k] += highColumn[k]*highNibble + lowColumn[k]*lowNibble
+		}
+	}
+
+	// Rows 2*i and 2*i+1 share a uint64. Convert each to 4 bits,
+	// concatenate them back to 8 bits and xor with the hash
+	var res [32]byte
+	for i := range res {
+		packedSums := sums[i/2] >> (32 * (i % 2))
+		high := byte((packedSums & 0xFFFF) >> 10)
+		low := byte(((packedSums >> 16) & 0xFFFF) >> 10)
+		res[i] = hashBytes[i] ^ (high<<4 | low)
+	}
+	// Hash again
+	writer := hashes.NewHeavyHashWriter()
+	writer.InfallibleWrite(res[:])
+	return writer.Finalize()
+}
@@ -3,6 +3,7 @@ package pow
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -21,6 +22,29 @@ func BenchmarkMatrix_HeavyHash(b *testing.B) {
 	}
 }
 
+func BenchmarkPackedMatrix_HeavyHash(b *testing.B) {
+	input := []byte("BenchmarkMatrix_HeavyHash")
+	writer := hashes.NewPoWHashWriter()
+	writer.InfallibleWrite(input)
+	hash := writer.Finalize()
+	matrix := generateMatrix(hash).pack()
+	for i := 0; i < b.N; i++ {
+		hash = matrix.HeavyHash(hash)
+	}
+}
+
+func BenchmarkMatrix_Pack(b *testing.B) {
+	r := rand.New(rand.NewSource(0))
+	h := [32]byte{}
+	r.Read(h[:])
+	hash := externalapi.NewDomainHashFromByteArray(&h)
+	matrix := generateMatrix(hash)
+
+	for i := 0; i < b.N; i++ {
+		matrix.pack()
+	}
+}
+
 func BenchmarkMatrix_Generate(b *testing.B) {
 	r := rand.New(rand.NewSource(0))
 	h := [32]byte{}
@@ -74,6 +98,88 @@ func TestMatrix_Rank(t *testing.T) {
 	}
 }
 
+// computeRankFloat is the floating point rank computation that computeRank replaced.
+// It's kept as a reference for TestMatrix_RankMatchesFloat
+func (mat *matrix) computeRankFloat() int {
+	const eps float64 = 1e-9
+	var B [64][64]float64
+	for i := range B {
+		for j := range B[0] {
+			B[i][j] = float64(mat[i][j])
+		}
+	}
+	var rank int
+	var rowSelected [64]bool
+	for i := 0; i < 64; i++ {
+		var j int
+		for j = 0; j < 64; j++ {
+			if !rowSelected[j] && math.Abs(B[j][i]) > eps {
+				break
+			}
+		}
+		if j != 64 {
+			rank++
+			rowSelected[j] = true
+			for p := i + 1; p < 64; p++ {
+				B[j][p] /= B[j][i]
+			}
+			for k := 0; k < 64; k++ {
+				if k != j && math.Abs(B[k][i]) > eps {
+					for p := i + 1; p < 64; p++ {
+						B[k][p] -= B[j][p] * B[k][i]
+					}
+				}
+			}
+		}
+	}
+	return rank
+}
+
+func TestMatrix_RankMatchesFloat(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	randomMatrix := func() *matrix {
+		var mat matrix
+		for i := range mat {
+			for j := range mat[i] {
+				mat[i][j] = uint16(r.Intn(16))
+			}
+		}
+		return &mat
+	}
+	// lowRankMatrix returns a matrix whose rows are all copies of its first rank rows
+	lowRankMatrix := func(rank int) *matrix {
+		mat := randomMatrix()
+		for i := rank; i < 64; i++ {
+			mat[i] = mat[r.Intn(rank)]
+		}
+		return mat
+	}
+
+	matrices := []*matrix{{}, &testMatrix}
+	for i := 0; i < 20; i++ {
+		matrices = append(matrices, randomMatrix())
+	}
+	for _, rank := range []int{1, 2, 10, 32, 63} {
+		matrices = append(matrices, lowRankMatrix(rank))
+	}
+	// A matrix with a zero column
+	zeroColumnMatrix := randomMatrix()
+	for i := range zeroColumnMatrix {
+		zeroColumnMatrix[i][7] = 0
+	}
+	matrices = append(matrices, zeroColumnMatrix)
+
+	for i, mat := range matrices {
+		expected := mat.computeRankFloat()
+		if rank := mat.computeRank(); rank != expected {
+			t.Fatalf("matrix %d: computeRank returned %d, while the float rank is %d", i, rank, expected)
+		}
+		if rank := mat.computeRankExact(); rank != expected {
+			t.Fatalf("matrix %d: computeRankExact returned %d, while the float rank is %d", i, rank, expected)
+		}
+	}
+}
+
 func TestGenerateMatrix(t *testing.T) {
 	hashBytes := [32]byte{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42}
 	hash := externalapi.NewDomainHashFromByteArray(&hashBytes)
@@ -166,6 +272,60 @@ func TestMatrix_HeavyHash(t *testing.T) {
 
 }
 
+func TestPackedMatrix_HeavyHash(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	randomHash := func() *externalapi.DomainHash {
+		h := [32]byte{}
+		r.Read(h[:])
+		return externalapi.NewDomainHashFromByteArray(&h)
+	}
+
+	// The all-15 matrix and hash give the highest possible sums
+	var maximalMatrix matrix
+	for i := range maximalMatrix {
+		for j := range maximalMatrix[i] {
+			maximalMatrix[i][j] = 0x0F
+		}
+	}
+	maximalHash := externalapi.NewDomainHashFromByteArray(&[32]byte{
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	})
+
+	tests := []struct {
+		name   string
+		matrix *matrix
+		hashes []*externalapi.DomainHash
+	}{
+		{name: "test vector", matrix: &testMatrix, hashes: []*externalapi.DomainHash{randomHash(), randomHash()}},
+		{name: "maximal", matrix: &maximalMatrix, hashes: []*externalapi.DomainHash{maximalHash, randomHash()}},
+		{name: "zero", matrix: &matrix{}, hashes: []*externalapi.DomainHash{maximalHash, randomHash()}},
+	}
+	for i := 0; i < 20; i++ {
+		var hashes []*externalapi.DomainHash
+		for j := 0; j < 50; j++ {
+			hashes = append(hashes, randomHash())
+		}
+		tests = append(tests, struct {
+			name   string
+			matrix *matrix
+			hashes []*externalapi.DomainHash
+		}{name: "random", matrix: generateMatrix(randomHash()), hashes: hashes})
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.pack()
+		for _, hash := range test.hashes {
+			expected := test.matrix.HeavyHash(hash)
+			actual := packed.HeavyHash(hash)
+			if !expected.Equal(actual) {
+				t.Fatalf("%s: packed HeavyHash of %s is %s, while the matrix HeavyHash is %s",
+					test.name, hash, actual, expected)
+			}
+		}
+	}
+}
+
 var testMatrix = matrix{
 	{13, 2, 14, 13, 2, 15, 14, 3, 10, 4, 1, 8, 4, 3, 8, 15, 15, 15, 15, 15, 2, 11, 15, 15, 15, 1, 7, 12, 12, 4, 2, 0, 6, 1, 14, 10, 12, 14, 15, 8, 10, 12, 0, 5, 13, 3, 14, 10, 10, 6, 12, 11, 11, 7, 6, 6, 10, 2, 2, 4, 11, 12, 0, 5},
 	{4, 13, 0, 2, 1, 15, 13, 13, 11, 2, 5, 12, 15, 7, 0, 10, 7, 2, 6, 3, 12, 0, 12, 0, 2, 6, 7, 7, 7, 7, 10, 12, 11, 14, 12, 12, 4, 11, 10, 0, 10, 11, 2, 10, 1, 7, 7, 12, 15, 9, 5, 14, 9, 12, 3, 0, 12, 13, 4, 13, 8, 15, 11, 6},
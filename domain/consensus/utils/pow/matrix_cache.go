@@ -0,0 +1,81 @@
+package pow
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// matrixCacheCapacity is the number of matrices NewState keeps around.
+// A packed matrix takes 8KB, so the cache takes up to 8MB
+const matrixCacheCapacity = 1024
+
+// cachedMatrices is shared by everything that creates a State, so that a header
+// that's checked more than once, such as for its block level and then for its
+// proof of work, or a block template that's refreshed, only generates its matrix once
+var cachedMatrices = newMatrixCache(matrixCacheCapacity)
+
+type matrixCacheEntry struct {
+	prePowHash externalapi.DomainHash
+	matrix     *packedMatrix
+}
+
+// matrixCache is a least-recently-used cache of packed matrices keyed by
+// the pre-PoW hash they were generated from. It's safe for concurrent use
+type matrixCache struct {
+	lock     sync.Mutex
+	capacity int
+	entries  map[externalapi.DomainHash]*list.Element
+	// recentlyUsed holds the entries from the most recently used to the least
+	recentlyUsed *list.List
+}
+
+func newMatrixCache(capacity int) *matrixCache {
+	return &matrixCache{
+		capacity:     capacity,
+		entries:      make(map[externalapi.DomainHash]*list.Element, capacity),
+		recentlyUsed: list.New(),
+	}
+}
+
+func (c *matrixCache) get(prePowHash *externalapi.DomainHash) (*packedMatrix, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[*prePowHash]
+	if !ok {
+		return nil, false
+	}
+	c.recentlyUsed.MoveToFront(element)
+	return element.Value.(*matrixCacheEntry).matrix, true
+}
+
+func (c *matrixCache) add(prePowHash *externalapi.DomainHash, matrix *packedMatrix) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, ok := c.entries[*prePowHash]; ok {
+		c.recentlyUsed.MoveToFront(element)
+		return
+	}
+	c.entries[*prePowHash] = c.recentlyUsed.PushFront(&matrixCacheEntry{prePowHash: *prePowHash, matrix: matrix})
+
+	if c.recentlyUsed.Len() > c.capacity {
+		leastRecentlyUsed := c.recentlyUsed.Back()
+		c.recentlyUsed.Remove(leastRecentlyUsed)
+		delete(c.entries, leastRecentlyUsed.Value.(*matrixCacheEntry).prePowHash)
+	}
+}
+
+// packedMatrixForPrePowHash returns the packed matrix generated from the given
+// pre-PoW hash. The returned matrix is shared, and must not be modified
+func packedMatrixForPrePowHash(prePowHash *externalapi.DomainHash) *packedMatrix {
+	matrix, ok := cachedMatrices.get(prePowHash)
+	if ok {
+		return matrix
+	}
+	matrix = generateMatrix(prePowHash).pack()
+	cachedMatrices.add(prePowHash, matrix)
+	return matrix
+}
@@ -0,0 +1,51 @@
+package pow
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+func TestMatrixCache(t *testing.T) {
+	hash := func(i byte) *externalapi.DomainHash {
+		return externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{i})
+	}
+	cache := newMatrixCache(2)
+	matrices := []*packedMatrix{{}, {}, {}}
+
+	cache.add(hash(0), matrices[0])
+	cache.add(hash(1), matrices[1])
+
+	// Using the first entry makes the second one the least recently used
+	matrix, ok := cache.get(hash(0))
+	if !ok || matrix != matrices[0] {
+		t.Fatalf("Expected to find the first matrix")
+	}
+	cache.add(hash(2), matrices[2])
+
+	if _, ok := cache.get(hash(1)); ok {
+		t.Fatalf("Expected the least recently used matrix to be evicted")
+	}
+	for _, i := range []byte{0, 2} {
+		matrix, ok := cache.get(hash(i))
+		if !ok || matrix != matrices[i] {
+			t.Fatalf("Expected to find matrix %d", i)
+		}
+	}
+	if len(cache.entries) != 2 || cache.recentlyUsed.Len() != 2 {
+		t.Fatalf("Expected the cache to hold 2 matrices, got %d and %d",
+			len(cache.entries), cache.recentlyUsed.Len())
+	}
+}
+
+func TestPackedMatrixForPrePowHash(t *testing.T) {
+	prePowHash := externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1, 2, 3})
+
+	matrix := packedMatrixForPrePowHash(prePowHash)
+	if *matrix != *generateMatrix(prePowHash).pack() {
+		t.Fatalf("The cached matrix doesn't match the generated one")
+	}
+	if packedMatrixForPrePowHash(prePowHash) != matrix {
+		t.Fatalf("Expected the matrix to be returned from the cache")
+	}
+}
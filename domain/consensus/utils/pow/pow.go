@@ -14,7 +14,7 @@ import (
 
 // State is an intermediate data structure with pre-computed values to speed up mining.
 type State struct {
-	mat        matrix
+	mat        packedMatrix
 	Timestamp  int64
 	Nonce      uint64
 	Target     big.Int
@@ -36,7 +36,7 @@ func NewState(header externalapi.MutableBlockHeader) *State {
 	return &State{
 		Target:     *target,
 		prePowHash: *prePowHash,
-		mat:        *generateMatrix(prePowHash),
+		mat:        *packedMatrixForPrePowHash(prePowHash),
 		Timestamp:  timestamp,
 		Nonce:      nonce,
 	}
@@ -62,6 +62,13 @@ func (state *State) CalculateProofOfWorkValue() *big.Int {
 	return toBig(heavyHash)
 }
 
+// PrePowHash returns the hash of the header with its timestamp and nonce zeroed out.
+// External miners combine it with the timestamp and the nonce in order to calculate the proof of work
+func (state *State) PrePowHash() *externalapi.DomainHash {
+	prePowHash := state.prePowHash
+	return &prePowHash
+}
+
 // IncrementNonce the nonce in State by 1
 func (state *State) IncrementNonce() {
 	state.Nonce++
@@ -1,6 +1,7 @@
 package dagconfig
 
 import (
+	"math"
 	"time"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/constants"
@@ -85,4 +86,8 @@ const (
 	defaultHalvingPhaseDaaScore = 31557600
 
 	defaultMergeDepth = 3600
+
+	// coinbasePayoutSplittingNeverActive is the activation DAA score of coinbase
+	// payout splitting for networks it isn't scheduled on
+	coinbasePayoutSplittingNeverActive = math.MaxUint64
 )
@@ -182,6 +182,10 @@ type Params struct {
 	// to its halving phase
 	HalvingPhaseDaaScore uint64
 
+	// CoinbasePayoutSplittingActivationDAAScore is the DAA score from which a block's coinbase
+	// payload may split its rewards between several script public keys, in proportion to their weights
+	CoinbasePayoutSplittingActivationDAAScore uint64
+
 	DisallowDirectBlocksOnTopOfGenesis bool
 
 	// MaxBlockLevel is the maximum possible block level.
@@ -272,6 +276,8 @@ var MainnetParams = Params{
 	// This means that any block that has a level lower or equal to genesis will be level 0.
 	MaxBlockLevel: 225,
 	MergeDepth:    defaultMergeDepth,
+
+	CoinbasePayoutSplittingActivationDAAScore: coinbasePayoutSplittingNeverActive,
 }
 
 // TestnetParams defines the network parameters for the test Kaspa network.
@@ -336,6 +342,8 @@ var TestnetParams = Params{
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
+
+	CoinbasePayoutSplittingActivationDAAScore: coinbasePayoutSplittingNeverActive,
 }
 
 // SimnetParams defines the network parameters for the simulation test Kaspa
@@ -402,6 +410,8 @@ var SimnetParams = Params{
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
+
+	CoinbasePayoutSplittingActivationDAAScore: 0,
 }
 
 // DevnetParams defines the network parameters for the development Kaspa network.
@@ -464,6 +474,8 @@ var DevnetParams = Params{
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
+
+	CoinbasePayoutSplittingActivationDAAScore: 0,
 }
 
 // ErrDuplicateNet describes an error where the parameters for a Kaspa
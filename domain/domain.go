@@ -11,6 +11,7 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/miningmanager"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 	"github.com/shatll-s/nexelliad/domain/prefixmanager"
 	"github.com/shatll-s/nexelliad/domain/prefixmanager/prefix"
 	infrastructuredatabase "github.com/shatll-s/nexelliad/infrastructure/db/database"
@@ -26,6 +27,7 @@ type Domain interface {
 	CommitStagingConsensus() error
 	DeleteStagingConsensus() error
 	ConsensusEventsChannel() chan externalapi.ConsensusEvent
+	MempoolEventsChannel() chan miningmanagermodel.MempoolEvent
 }
 
 type domain struct {
@@ -36,12 +38,17 @@ type domain struct {
 	consensusConfig        *consensus.Config
 	db                     infrastructuredatabase.Database
 	consensusEventsChannel chan externalapi.ConsensusEvent
+	mempoolEventsChannel   chan miningmanagermodel.MempoolEvent
 }
 
 func (d *domain) ConsensusEventsChannel() chan externalapi.ConsensusEvent {
 	return d.consensusEventsChannel
 }
 
+func (d *domain) MempoolEventsChannel() chan miningmanagermodel.MempoolEvent {
+	return d.mempoolEventsChannel
+}
+
 func (d *domain) Consensus() externalapi.Consensus {
 	return *d.consensus
 }
@@ -209,6 +216,7 @@ func New(consensusConfig *consensus.Config, mempoolConfig *mempool.Config, db in
 		consensusConfig:        consensusConfig,
 		db:                     db,
 		consensusEventsChannel: consensusEventsChan,
+		mempoolEventsChannel:   make(chan miningmanagermodel.MempoolEvent, 100e3),
 	}
 
 	if shouldMigrate {
@@ -222,6 +230,7 @@ func New(consensusConfig *consensus.Config, mempoolConfig *mempool.Config, db in
 
 	// We create a consensus wrapper because the actual consensus might change
 	consensusReference := consensusreference.NewConsensusReference(&domainInstance.consensus)
-	domainInstance.miningManager = miningManagerFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+	domainInstance.miningManager = miningManagerFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig,
+		domainInstance.mempoolEventsChannel)
 	return domainInstance, nil
 }
@@ -0,0 +1,205 @@
+package historyindex
+
+import (
+	"sync"
+
+	"github.com/shatll-s/nexelliad/domain"
+	"github.com/shatll-s/nexelliad/domain/chainindex"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+// HistoryIndex maintains, for every script public key, the history of the
+// transactions accepted by the virtual selected parent chain that paid to
+// it or spent from it
+type HistoryIndex struct {
+	domain domain.Domain
+	store  *historyIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new address history index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*HistoryIndex, error) {
+	historyIndex := &HistoryIndex{
+		domain: domain,
+		store:  newHistoryIndexStore(database),
+	}
+	err := chainindex.Sync(domain.Consensus(), &chainindex.Index{
+		VirtualSelectedParent:       historyIndex.store.getVirtualSelectedParent,
+		RemoveChainBlocks:           historyIndex.removeChainBlocks,
+		AddChainBlocks:              historyIndex.addChainBlocks,
+		UpdateVirtualSelectedParent: historyIndex.store.updateVirtualSelectedParent,
+		Commit:                      historyIndex.store.commit,
+		Discard:                     historyIndex.store.discard,
+		Reset:                       historyIndex.Reset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return historyIndex, nil
+}
+
+// Reset deletes the whole history index and resyncs it from
+// the acceptance data of the virtual selected parent chain above
+// the pruning point.
+func (hi *HistoryIndex) Reset() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "HistoryIndex.Reset")
+	defer onEnd()
+
+	hi.mutex.Lock()
+	defer hi.mutex.Unlock()
+
+	err := hi.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualSelectedParent, err := chainindex.ForEachChunkAbovePruningPoint(hi.domain.Consensus(),
+		func(chainBlocksChunk []*externalapi.DomainHash) error {
+			err := hi.addChainBlocks(chainBlocksChunk)
+			if err != nil {
+				return err
+			}
+			return hi.store.commit()
+		})
+	if err != nil {
+		return err
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	hi.store.updateVirtualSelectedParent(virtualSelectedParent)
+	return hi.store.commit()
+}
+
+// Update updates the history index with the given DAG selected parent chain changes
+func (hi *HistoryIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "HistoryIndex.Update")
+	defer onEnd()
+
+	hi.mutex.Lock()
+	defer hi.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if chainChanges == nil || (len(chainChanges.Added) == 0 && len(chainChanges.Removed) == 0) {
+		return nil
+	}
+
+	log.Tracef("Updating history index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+	err := hi.removeChainBlocks(chainChanges.Removed)
+	if err != nil {
+		hi.store.discard()
+		return err
+	}
+
+	err = hi.addChainBlocks(chainChanges.Added)
+	if err != nil {
+		hi.store.discard()
+		return err
+	}
+
+	if len(chainChanges.Added) > 0 {
+		hi.store.updateVirtualSelectedParent(chainChanges.Added[len(chainChanges.Added)-1])
+	}
+
+	return hi.store.commit()
+}
+
+func (hi *HistoryIndex) addChainBlocks(addedChainBlocks []*externalapi.DomainHash) error {
+	return hi.forEachEntry(addedChainBlocks, hi.store.add)
+}
+
+// removeChainBlocks removes the entries of chain blocks that left the virtual selected parent
+// chain. Their acceptance data is still available, so the entries are rebuilt the same way they
+// were built when the blocks were added in order to find their keys
+func (hi *HistoryIndex) removeChainBlocks(removedChainBlocks []*externalapi.DomainHash) error {
+	return hi.forEachEntry(removedChainBlocks, hi.store.remove)
+}
+
+// forEachEntry calls f with every history entry of the transactions accepted by the given chain blocks
+func (hi *HistoryIndex) forEachEntry(chainBlocks []*externalapi.DomainHash,
+	f func(scriptPublicKey *externalapi.ScriptPublicKey, entry *HistoryEntry)) error {
+
+	return chainindex.ForEachChainBlockAcceptanceData(hi.domain.Consensus(), chainBlocks,
+		func(chainBlockHash *externalapi.DomainHash, chainBlockAcceptanceData externalapi.AcceptanceData) error {
+			chainBlockHeader, err := hi.domain.Consensus().GetBlockHeader(chainBlockHash)
+			if err != nil {
+				return err
+			}
+			acceptingDAAScore := chainBlockHeader.DAAScore()
+
+			for _, blockAcceptanceData := range chainBlockAcceptanceData {
+				for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+					if !transactionAcceptanceData.IsAccepted {
+						continue
+					}
+					transactionEntries(transactionAcceptanceData, acceptingDAAScore, f)
+				}
+			}
+			return nil
+		})
+}
+
+// transactionEntries calls f with the history entries of an accepted transaction. The amounts
+// of all the inputs that spend from the same script public key are summed into a single sent
+// entry, and the amounts of all the outputs that pay to it into a single received entry
+func transactionEntries(transactionAcceptanceData *externalapi.TransactionAcceptanceData, acceptingDAAScore uint64,
+	f func(scriptPublicKey *externalapi.ScriptPublicKey, entry *HistoryEntry)) {
+
+	transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+
+	type amountsKey struct {
+		scriptPublicKey string
+		direction       Direction
+	}
+	amounts := make(map[amountsKey]uint64)
+	scriptPublicKeys := make(map[string]*externalapi.ScriptPublicKey)
+	// keys keeps the order in which script public keys were encountered, so
+	// that entries are always passed to f in the same order
+	var keys []amountsKey
+	addAmount := func(scriptPublicKey *externalapi.ScriptPublicKey, direction Direction, amount uint64) {
+		key := amountsKey{scriptPublicKey: serializeScriptPublicKey(scriptPublicKey), direction: direction}
+		if _, ok := amounts[key]; !ok {
+			keys = append(keys, key)
+			scriptPublicKeys[key.scriptPublicKey] = scriptPublicKey
+		}
+		amounts[key] += amount
+	}
+
+	for _, utxoEntry := range transactionAcceptanceData.TransactionInputUTXOEntries {
+		addAmount(utxoEntry.ScriptPublicKey(), DirectionSent, utxoEntry.Amount())
+	}
+	for _, output := range transactionAcceptanceData.Transaction.Outputs {
+		addAmount(output.ScriptPublicKey, DirectionReceived, output.Value)
+	}
+
+	for _, key := range keys {
+		f(scriptPublicKeys[key.scriptPublicKey], &HistoryEntry{
+			AcceptingDAAScore: acceptingDAAScore,
+			TransactionID:     *transactionID,
+			Direction:         key.direction,
+			Amount:            amounts[key],
+		})
+	}
+}
+
+// Entries returns, ordered by accepting DAA score, up to limit history entries of the
+// given scriptPublicKey that come after the given entry. Pass the last entry of one
+// call as after to get the entries that follow it. A nil after starts from the
+// oldest entry, and a limit of 0 returns all the remaining entries
+func (hi *HistoryIndex) Entries(scriptPublicKey *externalapi.ScriptPublicKey, after *HistoryEntry,
+	limit int) ([]*HistoryEntry, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "HistoryIndex.Entries")
+	defer onEnd()
+
+	hi.mutex.Lock()
+	defer hi.mutex.Unlock()
+
+	return hi.store.getEntries(scriptPublicKey, after, limit)
+}
@@ -0,0 +1,37 @@
+package historyindex
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// Direction is whether a transaction moved funds to or from a script public key
+type Direction byte
+
+const (
+	// DirectionReceived means the transaction has outputs that pay to the script public key
+	DirectionReceived Direction = iota
+
+	// DirectionSent means the transaction spends outputs that were paid to the script public key
+	DirectionSent
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionReceived:
+		return "received"
+	case DirectionSent:
+		return "sent"
+	default:
+		return "unknown"
+	}
+}
+
+// HistoryEntry is a single record in the transaction history of a script public key.
+// A transaction that both spends from and pays to the same script public key has
+// one entry for every direction
+type HistoryEntry struct {
+	AcceptingDAAScore uint64
+	TransactionID     externalapi.DomainTransactionID
+	Direction         Direction
+	Amount            uint64
+}
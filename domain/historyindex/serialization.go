@@ -0,0 +1,62 @@
+package historyindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const (
+	daaScoreSize = 8
+
+	// entryKeySize is the size of the part of an entry's database key that comes after
+	// its script public key bucket. The DAA score is serialized in big-endian so that
+	// keys are sorted by it
+	entryKeySize = daaScoreSize + externalapi.DomainHashSize + 1
+
+	amountSize = 8
+)
+
+// entryKey is the part of an entry's database key that comes after its script public key bucket
+type entryKey [entryKeySize]byte
+
+func serializeEntryKey(acceptingDAAScore uint64, transactionID *externalapi.DomainTransactionID,
+	direction Direction) entryKey {
+
+	var key entryKey
+	binary.BigEndian.PutUint64(key[:daaScoreSize], acceptingDAAScore)
+	copy(key[daaScoreSize:daaScoreSize+externalapi.DomainHashSize], transactionID.ByteSlice())
+	key[entryKeySize-1] = byte(direction)
+	return key
+}
+
+func serializeAmount(amount uint64) []byte {
+	serializedAmount := make([]byte, amountSize)
+	binary.LittleEndian.PutUint64(serializedAmount, amount)
+	return serializedAmount
+}
+
+func deserializeEntry(serializedKey []byte, serializedAmount []byte) (*HistoryEntry, error) {
+	if len(serializedKey) != entryKeySize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "history entry key has size %d instead of %d",
+			len(serializedKey), entryKeySize)
+	}
+	if len(serializedAmount) != amountSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "history entry amount has size %d instead of %d",
+			len(serializedAmount), amountSize)
+	}
+
+	transactionID, err := externalapi.NewDomainTransactionIDFromByteSlice(
+		serializedKey[daaScoreSize : daaScoreSize+externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	return &HistoryEntry{
+		AcceptingDAAScore: binary.BigEndian.Uint64(serializedKey[:daaScoreSize]),
+		TransactionID:     *transactionID,
+		Direction:         Direction(serializedKey[entryKeySize-1]),
+		Amount:            binary.LittleEndian.Uint64(serializedAmount),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package historyindex
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeEntry(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var transactionIDBytes [externalapi.DomainHashSize]byte
+		r.Read(transactionIDBytes[:])
+		entry := &HistoryEntry{
+			AcceptingDAAScore: r.Uint64(),
+			TransactionID:     *externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes),
+			Direction:         Direction(r.Intn(2)),
+			Amount:            r.Uint64(),
+		}
+		key := serializeEntryKey(entry.AcceptingDAAScore, &entry.TransactionID, entry.Direction)
+		result, err := deserializeEntry(key[:], serializeAmount(entry.Amount))
+		if err != nil {
+			t.Fatalf("Failed deserializing history entry: %v", err)
+		}
+		if !reflect.DeepEqual(result, entry) {
+			t.Fatalf("Expected \n %+v \n==\n %+v\n", entry, result)
+		}
+	}
+}
+
+func Test_serializeEntryKeyOrder(t *testing.T) {
+	lowerTransactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{0xff})
+	higherTransactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{0})
+
+	// Entries must be sorted by DAA score first, whatever their transaction IDs are
+	lowerKey := serializeEntryKey(0xff, lowerTransactionID, DirectionSent)
+	higherKey := serializeEntryKey(0x100, higherTransactionID, DirectionReceived)
+	if string(lowerKey[:]) >= string(higherKey[:]) {
+		t.Fatalf("Expected the key of DAA score 0xff to be lower than the key of DAA score 0x100")
+	}
+}
+
+func Test_deserializeEntryFailure(t *testing.T) {
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	key := serializeEntryKey(1, transactionID, DirectionReceived)
+
+	_, err := deserializeEntry(key[:len(key)-1], serializeAmount(1))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+
+	serializedAmount := serializeAmount(1)
+	_, err = deserializeEntry(key[:], serializedAmount[:len(serializedAmount)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
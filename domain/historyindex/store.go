@@ -0,0 +1,231 @@
+package historyindex
+
+import (
+	"encoding/binary"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var historyIndexBucket = database.MakeBucket([]byte("history-index"))
+var virtualSelectedParentKey = database.MakeBucket([]byte("")).Key([]byte("history-index-virtual-selected-parent"))
+
+// stagedEntryKey identifies an entry while it's staged. scriptPublicKey
+// is the serialized script public key the entry's bucket is derived from
+type stagedEntryKey struct {
+	scriptPublicKey string
+	entryKey        entryKey
+}
+
+type historyIndexStore struct {
+	database database.Database
+	toAdd    map[stagedEntryKey]uint64
+	toRemove map[stagedEntryKey]struct{}
+
+	virtualSelectedParent *externalapi.DomainHash
+}
+
+func newHistoryIndexStore(database database.Database) *historyIndexStore {
+	return &historyIndexStore{
+		database: database,
+		toAdd:    make(map[stagedEntryKey]uint64),
+		toRemove: make(map[stagedEntryKey]struct{}),
+	}
+}
+
+func (his *historyIndexStore) add(scriptPublicKey *externalapi.ScriptPublicKey, entry *HistoryEntry) {
+	log.Tracef("Adding %s entry of transaction %s", entry.Direction, entry.TransactionID)
+
+	key := stagedEntryKey{
+		scriptPublicKey: serializeScriptPublicKey(scriptPublicKey),
+		entryKey:        serializeEntryKey(entry.AcceptingDAAScore, &entry.TransactionID, entry.Direction),
+	}
+	// A transaction that was removed by a chain block that left the selected parent
+	// chain may be re-accepted by a block that has joined it
+	delete(his.toRemove, key)
+	his.toAdd[key] = entry.Amount
+}
+
+func (his *historyIndexStore) remove(scriptPublicKey *externalapi.ScriptPublicKey, entry *HistoryEntry) {
+	log.Tracef("Removing %s entry of transaction %s", entry.Direction, entry.TransactionID)
+
+	key := stagedEntryKey{
+		scriptPublicKey: serializeScriptPublicKey(scriptPublicKey),
+		entryKey:        serializeEntryKey(entry.AcceptingDAAScore, &entry.TransactionID, entry.Direction),
+	}
+	delete(his.toAdd, key)
+	his.toRemove[key] = struct{}{}
+}
+
+func (his *historyIndexStore) updateVirtualSelectedParent(virtualSelectedParent *externalapi.DomainHash) {
+	his.virtualSelectedParent = virtualSelectedParent
+}
+
+func (his *historyIndexStore) discard() {
+	his.toAdd = make(map[stagedEntryKey]uint64)
+	his.toRemove = make(map[stagedEntryKey]struct{})
+	his.virtualSelectedParent = nil
+}
+
+func (his *historyIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "historyIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := his.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for keyToRemove := range his.toRemove {
+		err := dbTransaction.Delete(his.convertStagedEntryKeyToKey(keyToRemove))
+		if err != nil {
+			return err
+		}
+	}
+
+	for keyToAdd, amount := range his.toAdd {
+		err := dbTransaction.Put(his.convertStagedEntryKeyToKey(keyToAdd), serializeAmount(amount))
+		if err != nil {
+			return err
+		}
+	}
+
+	if his.virtualSelectedParent != nil {
+		err = dbTransaction.Put(virtualSelectedParentKey, his.virtualSelectedParent.ByteSlice())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	his.discard()
+	return nil
+}
+
+func serializeScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) string {
+	var scriptPublicKeyBytes = make([]byte, 2+len(scriptPublicKey.Script)) // uint16
+	binary.LittleEndian.PutUint16(scriptPublicKeyBytes[:2], scriptPublicKey.Version)
+	copy(scriptPublicKeyBytes[2:], scriptPublicKey.Script)
+	return string(scriptPublicKeyBytes)
+}
+
+func (his *historyIndexStore) bucketForScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *database.Bucket {
+	return historyIndexBucket.Bucket([]byte(serializeScriptPublicKey(scriptPublicKey)))
+}
+
+func (his *historyIndexStore) convertStagedEntryKeyToKey(key stagedEntryKey) *database.Key {
+	return historyIndexBucket.Bucket([]byte(key.scriptPublicKey)).Key(key.entryKey[:])
+}
+
+func (his *historyIndexStore) isAnythingStaged() bool {
+	return len(his.toAdd) > 0 || len(his.toRemove) > 0
+}
+
+// getEntries returns, ordered by accepting DAA score, up to limit history entries
+// of the given scriptPublicKey that come after the given entry. A nil after starts
+// from the first entry, and a limit of 0 means no limit. The after entry itself
+// doesn't have to be in the index
+func (his *historyIndexStore) getEntries(scriptPublicKey *externalapi.ScriptPublicKey,
+	after *HistoryEntry, limit int) ([]*HistoryEntry, error) {
+
+	if his.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get history entries while staging isn't empty")
+	}
+
+	bucket := his.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := his.database.Cursor(bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var hasCurrent bool
+	if after == nil {
+		hasCurrent = cursor.First()
+	} else {
+		afterKey := serializeEntryKey(after.AcceptingDAAScore, &after.TransactionID, after.Direction)
+		// Seek leaves the cursor at the first key that is greater than or equal to
+		// afterKey, and only reports whether that key is afterKey itself
+		err = cursor.Seek(bucket.Key(afterKey[:]))
+		if err != nil && !database.IsNotFoundError(err) {
+			return nil, err
+		}
+		isAfterKeyFound := err == nil
+
+		_, err = cursor.Key()
+		if err == nil {
+			hasCurrent = true
+			if isAfterKeyFound {
+				hasCurrent = cursor.Next()
+			}
+		} else if !database.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	entries := make([]*HistoryEntry, 0)
+	for ; hasCurrent && (limit == 0 || len(entries) < limit); hasCurrent = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		serializedAmount, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		entry, err := deserializeEntry(key.Suffix(), serializedAmount)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (his *historyIndexStore) getVirtualSelectedParent() (*externalapi.DomainHash, error) {
+	if his.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual selected parent while staging isn't empty")
+	}
+
+	serializedHash, err := his.database.Get(virtualSelectedParentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return externalapi.NewDomainHashFromByteSlice(serializedHash)
+}
+
+func (his *historyIndexStore) deleteAll() error {
+	// First we delete the virtual selected parent, so if anything goes wrong, the history
+	// index will be marked as "not synced" and will be reset.
+	err := his.database.Delete(virtualSelectedParentKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := his.database.Cursor(historyIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = his.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package historyindex
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database/ldb"
+)
+
+func TestGetEntries(t *testing.T) {
+	database, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	store := newHistoryIndexStore(database)
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}}
+	otherScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{4, 5, 6}}
+
+	// Add the entries in reverse order to make sure they come back sorted by DAA score
+	const entryCount = 10
+	entries := make([]*HistoryEntry, entryCount)
+	for i := entryCount - 1; i >= 0; i-- {
+		entries[i] = &HistoryEntry{
+			AcceptingDAAScore: uint64(i) * 1000,
+			TransactionID:     *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{byte(entryCount - i)}),
+			Direction:         Direction(i % 2),
+			Amount:            uint64(i),
+		}
+		store.add(scriptPublicKey, entries[i])
+	}
+	store.add(otherScriptPublicKey, &HistoryEntry{AcceptingDAAScore: 1})
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %s", err)
+	}
+
+	all, err := store.getEntries(scriptPublicKey, nil, 0)
+	if err != nil {
+		t.Fatalf("getEntries: %s", err)
+	}
+	if len(all) != entryCount {
+		t.Fatalf("Expected %d entries but got %d", entryCount, len(all))
+	}
+	for i, entry := range all {
+		if *entry != *entries[i] {
+			t.Fatalf("Unexpected entry at position %d: expected %+v, got %+v", i, entries[i], entry)
+		}
+	}
+
+	var paged []*HistoryEntry
+	var after *HistoryEntry
+	for {
+		page, err := store.getEntries(scriptPublicKey, after, 4)
+		if err != nil {
+			t.Fatalf("getEntries: %s", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		after = page[len(page)-1]
+	}
+	if len(paged) != entryCount {
+		t.Fatalf("Expected %d paged entries but got %d", entryCount, len(paged))
+	}
+
+	// Removing entries, as done when their accepting block leaves the selected
+	// parent chain, must not break pages that start after them
+	store.remove(scriptPublicKey, entries[5])
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %s", err)
+	}
+	page, err := store.getEntries(scriptPublicKey, entries[5], 1)
+	if err != nil {
+		t.Fatalf("getEntries: %s", err)
+	}
+	if len(page) != 1 || *page[0] != *entries[6] {
+		t.Fatalf("Expected the page after a removed entry to start at %+v, got %v", entries[6], page)
+	}
+	all, err = store.getEntries(scriptPublicKey, nil, 0)
+	if err != nil {
+		t.Fatalf("getEntries: %s", err)
+	}
+	if len(all) != entryCount-1 {
+		t.Fatalf("Expected %d entries after the removal but got %d", entryCount-1, len(all))
+	}
+
+	err = store.deleteAll()
+	if err != nil {
+		t.Fatalf("deleteAll: %s", err)
+	}
+	all, err = store.getEntries(scriptPublicKey, nil, 0)
+	if err != nil {
+		t.Fatalf("getEntries: %s", err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("Expected no entries after deleteAll but got %d", len(all))
+	}
+}
@@ -0,0 +1,64 @@
+package miningmanager
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/hashes"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/serialization"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionhelper"
+	"github.com/pkg/errors"
+)
+
+// blockTemplateID returns an ID for the work in the given block template. The coinbase
+// transaction, and with it the hash merkle root, as well as the timestamp are left out,
+// so that templates given out for different coinbase data, or rebuilt over the same
+// virtual and the same transactions, share the same ID
+func blockTemplateID(blockTemplate *externalapi.DomainBlockTemplate) *externalapi.DomainHash {
+	writer := hashes.NewBlockTemplateIDWriter()
+	err := serializeBlockTemplateWork(writer, blockTemplate.Block)
+	if err != nil {
+		panic(errors.Wrap(err, "this should never happen. Hash digest should never return an error"))
+	}
+	return writer.Finalize()
+}
+
+func serializeBlockTemplateWork(writer hashes.HashWriter, block *externalapi.DomainBlock) error {
+	header := block.Header
+
+	err := serialization.WriteElements(writer, header.Version(), uint64(len(header.Parents())))
+	if err != nil {
+		return err
+	}
+	for _, blockLevelParents := range header.Parents() {
+		err := serialization.WriteElement(writer, uint64(len(blockLevelParents)))
+		if err != nil {
+			return err
+		}
+		for _, parent := range blockLevelParents {
+			err := serialization.WriteElement(writer, parent)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = serialization.WriteElements(writer, header.AcceptedIDMerkleRoot(), header.UTXOCommitment(), header.Bits(),
+		header.DAAScore(), header.BlueScore(), header.BlueWork().Bytes(), header.PruningPoint())
+	if err != nil {
+		return err
+	}
+
+	err = serialization.WriteElement(writer, uint64(len(block.Transactions)-1))
+	if err != nil {
+		return err
+	}
+	for i, transaction := range block.Transactions {
+		if i == transactionhelper.CoinbaseTransactionIndex {
+			continue
+		}
+		err := serialization.WriteElement(writer, *consensushashing.TransactionID(transaction))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,7 +1,6 @@
 package blocktemplatebuilder
 
 import (
-	"math"
 	"sort"
 
 	"github.com/shatll-s/nexelliad/domain/consensus/processes/coinbasemanager"
@@ -19,34 +18,25 @@ import (
 	"github.com/pkg/errors"
 )
 
-type candidateTx struct {
-	*consensusexternalapi.DomainTransaction
-	txValue  float64
-	gasLimit uint64
-
-	p     float64
-	start float64
-	end   float64
-
-	isMarkedForDeletion bool
-}
-
 // blockTemplateBuilder creates block templates for a miner to consume
 type blockTemplateBuilder struct {
 	consensusReference consensusreference.ConsensusReference
 	mempool            miningmanagerapi.Mempool
 	policy             policy
+	selectionPolicy    miningmanagerapi.TransactionSelectionPolicy
 
 	coinbasePayloadScriptPublicKeyMaxLength uint8
 }
 
 // New creates a new blockTemplateBuilder
 func New(consensusReference consensusreference.ConsensusReference, mempool miningmanagerapi.Mempool,
-	blockMaxMass uint64, coinbasePayloadScriptPublicKeyMaxLength uint8) miningmanagerapi.BlockTemplateBuilder {
+	selectionPolicy miningmanagerapi.TransactionSelectionPolicy, blockMaxMass uint64,
+	coinbasePayloadScriptPublicKeyMaxLength uint8) miningmanagerapi.BlockTemplateBuilder {
 	return &blockTemplateBuilder{
 		consensusReference: consensusReference,
 		mempool:            mempool,
 		policy:             policy{BlockMaxMass: blockMaxMass},
+		selectionPolicy:    selectionPolicy,
 
 		coinbasePayloadScriptPublicKeyMaxLength: coinbasePayloadScriptPublicKeyMaxLength,
 	}
@@ -120,30 +110,25 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 	coinbaseData *consensusexternalapi.DomainCoinbaseData) (*consensusexternalapi.DomainBlockTemplate, error) {
 
 	mempoolTransactions := btb.mempool.BlockCandidateTransactions()
-	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
 	for _, tx := range mempoolTransactions {
-		// Calculate the tx value
-		gasLimit := uint64(0)
 		if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
 			panic("We currently don't support non native subnetworks")
 		}
-		candidateTxs = append(candidateTxs, &candidateTx{
-			DomainTransaction: tx,
-			txValue:           btb.calcTxValue(tx),
-			gasLimit:          gasLimit,
-		})
 	}
+	log.Debugf("Considering %d transactions for inclusion to new block",
+		len(mempoolTransactions))
 
-	// Sort the candidate txs by subnetworkID.
-	sort.Slice(candidateTxs, func(i, j int) bool {
-		return subnetworks.Less(candidateTxs[i].SubnetworkID, candidateTxs[j].SubnetworkID)
+	selectedTxs := btb.selectionPolicy.SelectTransactions(mempoolTransactions, btb.policy.BlockMaxMass)
+	sort.Slice(selectedTxs, func(i, j int) bool {
+		return subnetworks.Less(selectedTxs[i].SubnetworkID, selectedTxs[j].SubnetworkID)
 	})
+	totalFees, totalMass := uint64(0), uint64(0)
+	for _, tx := range selectedTxs {
+		totalFees += tx.Fee
+		totalMass += tx.Mass
+	}
 
-	log.Debugf("Considering %d transactions for inclusion to new block",
-		len(candidateTxs))
-
-	blockTxs := btb.selectTransactions(candidateTxs)
-	blockTemplate, err := btb.consensusReference.Consensus().BuildBlockTemplate(coinbaseData, blockTxs.selectedTxs)
+	blockTemplate, err := btb.consensusReference.Consensus().BuildBlockTemplate(coinbaseData, selectedTxs)
 
 	invalidTxsErr := ruleerrors.ErrInvalidTransactionsInNewBlock{}
 	if errors.As(err, &invalidTxsErr) {
@@ -168,11 +153,17 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 	}
 
 	log.Debugf("Created new block template (%d transactions, %d in fees, %d mass, target difficulty %064x)",
-		len(blockTemplate.Block.Transactions), blockTxs.totalFees, blockTxs.totalMass, difficulty.CompactToBig(blockTemplate.Block.Header.Bits()))
+		len(blockTemplate.Block.Transactions), totalFees, totalMass, difficulty.CompactToBig(blockTemplate.Block.Header.Bits()))
 
 	return blockTemplate, nil
 }
 
+// SetTransactionSelectionPolicy sets the policy by which transactions are selected
+// from the mempool into block templates
+func (btb *blockTemplateBuilder) SetTransactionSelectionPolicy(selectionPolicy miningmanagerapi.TransactionSelectionPolicy) {
+	btb.selectionPolicy = selectionPolicy
+}
+
 // ModifyBlockTemplate modifies an existing block template to the requested coinbase data and updates the timestamp
 func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensusexternalapi.DomainCoinbaseData,
 	blockTemplateToModify *consensusexternalapi.DomainBlockTemplate) (*consensusexternalapi.DomainBlockTemplate, error) {
@@ -205,19 +196,3 @@ func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensuse
 
 	return blockTemplateToModify, nil
 }
-
-// calcTxValue calculates a value to be used in transaction selection.
-// The higher the number the more likely it is that the transaction will be
-// included in the block.
-func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction) float64 {
-	massLimit := btb.policy.BlockMaxMass
-
-	mass := tx.Mass
-	fee := tx.Fee
-	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
-		return float64(fee) / (float64(mass) / float64(massLimit))
-	}
-	// TODO: Replace with real gas once implemented
-	gasLimit := uint64(math.MaxUint64)
-	return float64(fee) / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
-}
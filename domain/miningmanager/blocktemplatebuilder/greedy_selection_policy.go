@@ -0,0 +1,62 @@
+package blocktemplatebuilder
+
+import (
+	"sort"
+
+	consensusexternalapi "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	miningmanagerapi "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+)
+
+// greedySelectionPolicy is a TransactionSelectionPolicy that selects the transactions
+// with the highest fee rates first
+type greedySelectionPolicy struct{}
+
+// NewGreedySelectionPolicy creates a new TransactionSelectionPolicy that selects
+// transactions in order of their fee rates
+func NewGreedySelectionPolicy() miningmanagerapi.TransactionSelectionPolicy {
+	return &greedySelectionPolicy{}
+}
+
+// SelectTransactions selects the candidate transactions in descending order of their
+// fee rates, skipping the ones that would make the block exceed blockMaxMass
+func (*greedySelectionPolicy) SelectTransactions(candidateTxs []*consensusexternalapi.DomainTransaction,
+	blockMaxMass uint64) []*consensusexternalapi.DomainTransaction {
+
+	sortedTxs := make([]*consensusexternalapi.DomainTransaction, len(candidateTxs))
+	copy(sortedTxs, candidateTxs)
+	sort.SliceStable(sortedTxs, func(i, j int) bool {
+		return feeRate(sortedTxs[i], 0) > feeRate(sortedTxs[j], 0)
+	})
+
+	return selectInOrder(sortedTxs, blockMaxMass)
+}
+
+// feeRate returns the fee rate of tx in sompi per gram, with its fee adjusted by feeDelta
+func feeRate(tx *consensusexternalapi.DomainTransaction, feeDelta int64) float64 {
+	if tx.Mass == 0 {
+		return 0
+	}
+	return (float64(tx.Fee) + float64(feeDelta)) / float64(tx.Mass)
+}
+
+// selectInOrder selects the given transactions in order, skipping the ones
+// that would make the block exceed blockMaxMass
+func selectInOrder(orderedTxs []*consensusexternalapi.DomainTransaction,
+	blockMaxMass uint64) []*consensusexternalapi.DomainTransaction {
+
+	selectedTxs := make([]*consensusexternalapi.DomainTransaction, 0, len(orderedTxs))
+	totalMass := uint64(0)
+	for _, tx := range orderedTxs {
+		// Also check for overflow
+		if totalMass+tx.Mass < totalMass || totalMass+tx.Mass > blockMaxMass {
+			log.Tracef("Tx %s would exceed the max block mass. "+
+				"As such, skipping it.", consensushashing.TransactionID(tx))
+			continue
+		}
+
+		selectedTxs = append(selectedTxs, tx)
+		totalMass += tx.Mass
+	}
+	return selectedTxs
+}
@@ -0,0 +1,147 @@
+package blocktemplatebuilder
+
+import (
+	"sort"
+	"sync"
+
+	consensusexternalapi "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	miningmanagerapi "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+)
+
+// priorityListSelectionPolicy is a TransactionSelectionPolicy that first selects the
+// transactions pinned by the operator, and then fills the rest of the block greedily
+type priorityListSelectionPolicy struct {
+	lock sync.RWMutex
+
+	transactionFeeDeltas map[consensusexternalapi.DomainTransactionID]int64
+	// scriptPublicKeyFeeDeltas is keyed by ScriptPublicKey.String()
+	scriptPublicKeyFeeDeltas map[string]int64
+}
+
+// PriorityListSelectionPolicy is a TransactionSelectionPolicy that is also a TransactionPriorityList
+type PriorityListSelectionPolicy interface {
+	miningmanagerapi.TransactionSelectionPolicy
+	miningmanagerapi.TransactionPriorityList
+}
+
+// NewPriorityListSelectionPolicy creates a new TransactionSelectionPolicy that selects
+// the transactions pinned through its TransactionPriorityList before all others
+func NewPriorityListSelectionPolicy() PriorityListSelectionPolicy {
+	return &priorityListSelectionPolicy{
+		transactionFeeDeltas:     make(map[consensusexternalapi.DomainTransactionID]int64),
+		scriptPublicKeyFeeDeltas: make(map[string]int64),
+	}
+}
+
+// SelectTransactions selects the pinned candidate transactions in descending order of their
+// fee rates, adjusted by their fee deltas, and then the rest of the candidate transactions in
+// descending order of their fee rates. Transactions that would make the block exceed
+// blockMaxMass are skipped
+func (plsp *priorityListSelectionPolicy) SelectTransactions(candidateTxs []*consensusexternalapi.DomainTransaction,
+	blockMaxMass uint64) []*consensusexternalapi.DomainTransaction {
+
+	plsp.lock.RLock()
+	defer plsp.lock.RUnlock()
+
+	type pinnedTx struct {
+		tx      *consensusexternalapi.DomainTransaction
+		feeRate float64
+	}
+	pinnedTxs := make([]pinnedTx, 0)
+	otherTxs := make([]*consensusexternalapi.DomainTransaction, 0, len(candidateTxs))
+	for _, tx := range candidateTxs {
+		feeDelta, isPinned := plsp.feeDelta(tx)
+		if !isPinned {
+			otherTxs = append(otherTxs, tx)
+			continue
+		}
+		pinnedTxs = append(pinnedTxs, pinnedTx{tx: tx, feeRate: feeRate(tx, feeDelta)})
+	}
+
+	sort.SliceStable(pinnedTxs, func(i, j int) bool {
+		return pinnedTxs[i].feeRate > pinnedTxs[j].feeRate
+	})
+	sort.SliceStable(otherTxs, func(i, j int) bool {
+		return feeRate(otherTxs[i], 0) > feeRate(otherTxs[j], 0)
+	})
+
+	orderedTxs := make([]*consensusexternalapi.DomainTransaction, 0, len(candidateTxs))
+	for _, pinned := range pinnedTxs {
+		orderedTxs = append(orderedTxs, pinned.tx)
+	}
+	orderedTxs = append(orderedTxs, otherTxs...)
+
+	return selectInOrder(orderedTxs, blockMaxMass)
+}
+
+// feeDelta returns the sum of the fee deltas tx is pinned with, through its ID and
+// through the script public keys it spends from or pays to, and whether it's pinned at all.
+// This function must be called with the lock held for reading
+func (plsp *priorityListSelectionPolicy) feeDelta(tx *consensusexternalapi.DomainTransaction) (int64, bool) {
+	feeDelta, isPinned := plsp.transactionFeeDeltas[*consensushashing.TransactionID(tx)]
+	if len(plsp.scriptPublicKeyFeeDeltas) == 0 {
+		return feeDelta, isPinned
+	}
+
+	// Each script public key contributes its fee delta once, however many
+	// times tx spends from or pays to it
+	matchedScriptPublicKeys := make(map[string]struct{})
+	matchScriptPublicKey := func(scriptPublicKey *consensusexternalapi.ScriptPublicKey) {
+		scriptPublicKeyString := scriptPublicKey.String()
+		scriptPublicKeyFeeDelta, ok := plsp.scriptPublicKeyFeeDeltas[scriptPublicKeyString]
+		if !ok {
+			return
+		}
+		if _, ok := matchedScriptPublicKeys[scriptPublicKeyString]; ok {
+			return
+		}
+		matchedScriptPublicKeys[scriptPublicKeyString] = struct{}{}
+		feeDelta += scriptPublicKeyFeeDelta
+		isPinned = true
+	}
+	for _, input := range tx.Inputs {
+		if input.UTXOEntry != nil {
+			matchScriptPublicKey(input.UTXOEntry.ScriptPublicKey())
+		}
+	}
+	for _, output := range tx.Outputs {
+		matchScriptPublicKey(output.ScriptPublicKey)
+	}
+
+	return feeDelta, isPinned
+}
+
+// PinTransaction pins the transaction with the given ID with the given fee delta,
+// replacing the fee delta it was previously pinned with, if any
+func (plsp *priorityListSelectionPolicy) PinTransaction(transactionID *consensusexternalapi.DomainTransactionID, feeDelta int64) {
+	plsp.lock.Lock()
+	defer plsp.lock.Unlock()
+
+	plsp.transactionFeeDeltas[*transactionID] = feeDelta
+}
+
+// UnpinTransaction unpins the transaction with the given ID
+func (plsp *priorityListSelectionPolicy) UnpinTransaction(transactionID *consensusexternalapi.DomainTransactionID) {
+	plsp.lock.Lock()
+	defer plsp.lock.Unlock()
+
+	delete(plsp.transactionFeeDeltas, *transactionID)
+}
+
+// PinScriptPublicKey pins all the transactions that spend from or pay to the given script
+// public key with the given fee delta, replacing the fee delta it was previously pinned with, if any
+func (plsp *priorityListSelectionPolicy) PinScriptPublicKey(scriptPublicKey *consensusexternalapi.ScriptPublicKey, feeDelta int64) {
+	plsp.lock.Lock()
+	defer plsp.lock.Unlock()
+
+	plsp.scriptPublicKeyFeeDeltas[scriptPublicKey.String()] = feeDelta
+}
+
+// UnpinScriptPublicKey unpins the transactions that spend from or pay to the given script public key
+func (plsp *priorityListSelectionPolicy) UnpinScriptPublicKey(scriptPublicKey *consensusexternalapi.ScriptPublicKey) {
+	plsp.lock.Lock()
+	defer plsp.lock.Unlock()
+
+	delete(plsp.scriptPublicKeyFeeDeltas, scriptPublicKey.String())
+}
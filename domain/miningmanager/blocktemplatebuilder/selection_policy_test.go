@@ -0,0 +1,91 @@
+package blocktemplatebuilder
+
+import (
+	"testing"
+
+	consensusexternalapi "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+)
+
+func newTestTransaction(fee uint64, mass uint64, outputScript []byte) *consensusexternalapi.DomainTransaction {
+	return &consensusexternalapi.DomainTransaction{
+		Version: 0,
+		Inputs:  []*consensusexternalapi.DomainTransactionInput{},
+		Outputs: []*consensusexternalapi.DomainTransactionOutput{{
+			Value:           1,
+			ScriptPublicKey: &consensusexternalapi.ScriptPublicKey{Script: outputScript, Version: 0},
+		}},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+		Payload:      []byte{},
+		Fee:          fee,
+		Mass:         mass,
+	}
+}
+
+func transactionIDs(txs []*consensusexternalapi.DomainTransaction) []consensusexternalapi.DomainTransactionID {
+	ids := make([]consensusexternalapi.DomainTransactionID, len(txs))
+	for i, tx := range txs {
+		ids[i] = *consensushashing.TransactionID(tx)
+	}
+	return ids
+}
+
+func assertSelected(t *testing.T, testName string, selectedTxs []*consensusexternalapi.DomainTransaction,
+	expectedTxs ...*consensusexternalapi.DomainTransaction) {
+
+	selectedIDs := transactionIDs(selectedTxs)
+	expectedIDs := transactionIDs(expectedTxs)
+	if len(selectedIDs) != len(expectedIDs) {
+		t.Fatalf("%s: expected %d selected transactions but got %d", testName, len(expectedIDs), len(selectedIDs))
+	}
+	for i := range selectedIDs {
+		if selectedIDs[i] != expectedIDs[i] {
+			t.Fatalf("%s: expected transaction %s at index %d but got %s", testName, expectedIDs[i], i, selectedIDs[i])
+		}
+	}
+}
+
+func TestGreedySelectionPolicy(t *testing.T) {
+	lowFeeRateTx := newTestTransaction(1000, 1000, []byte{1})
+	highFeeRateTx := newTestTransaction(5000, 1000, []byte{2})
+	heavyTx := newTestTransaction(30000, 10000, []byte{3})
+	candidateTxs := []*consensusexternalapi.DomainTransaction{lowFeeRateTx, heavyTx, highFeeRateTx}
+
+	policy := NewGreedySelectionPolicy()
+	assertSelected(t, "all fit", policy.SelectTransactions(candidateTxs, 100000),
+		highFeeRateTx, heavyTx, lowFeeRateTx)
+
+	// heavyTx doesn't fit once highFeeRateTx is selected, but lowFeeRateTx still does
+	assertSelected(t, "skip what doesn't fit", policy.SelectTransactions(candidateTxs, 10500),
+		highFeeRateTx, lowFeeRateTx)
+}
+
+func TestPriorityListSelectionPolicy(t *testing.T) {
+	poolScript := []byte{4}
+	lowFeeRateTx := newTestTransaction(1000, 1000, []byte{1})
+	highFeeRateTx := newTestTransaction(5000, 1000, []byte{2})
+	poolPayoutTx := newTestTransaction(100, 1000, poolScript)
+	candidateTxs := []*consensusexternalapi.DomainTransaction{lowFeeRateTx, highFeeRateTx, poolPayoutTx}
+
+	policy := NewPriorityListSelectionPolicy()
+	assertSelected(t, "nothing pinned", policy.SelectTransactions(candidateTxs, 2000),
+		highFeeRateTx, lowFeeRateTx)
+
+	policy.PinScriptPublicKey(&consensusexternalapi.ScriptPublicKey{Script: poolScript, Version: 0}, 0)
+	assertSelected(t, "pinned address", policy.SelectTransactions(candidateTxs, 2000),
+		poolPayoutTx, highFeeRateTx)
+
+	// Among pinned transactions, the fee delta decides the order
+	policy.PinTransaction(consensushashing.TransactionID(lowFeeRateTx), 0)
+	assertSelected(t, "pinned address and transaction", policy.SelectTransactions(candidateTxs, 2000),
+		lowFeeRateTx, poolPayoutTx)
+	policy.PinTransaction(consensushashing.TransactionID(lowFeeRateTx), -950)
+	assertSelected(t, "negative fee delta", policy.SelectTransactions(candidateTxs, 2000),
+		poolPayoutTx, lowFeeRateTx)
+
+	policy.UnpinTransaction(consensushashing.TransactionID(lowFeeRateTx))
+	policy.UnpinScriptPublicKey(&consensusexternalapi.ScriptPublicKey{Script: poolScript, Version: 0})
+	assertSelected(t, "unpinned", policy.SelectTransactions(candidateTxs, 2000),
+		highFeeRateTx, lowFeeRateTx)
+}
@@ -8,6 +8,7 @@ import (
 	consensusexternalapi "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+	miningmanagerapi "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 )
 
 const (
@@ -27,15 +28,29 @@ const (
 	rebalanceThreshold = 0.95
 )
 
-type selectedTransactions struct {
-	selectedTxs []*consensusexternalapi.DomainTransaction
-	txMasses    []uint64
-	txFees      []uint64
-	totalMass   uint64
-	totalFees   uint64
+type candidateTx struct {
+	*consensusexternalapi.DomainTransaction
+	txValue  float64
+	gasLimit uint64
+
+	p     float64
+	start float64
+	end   float64
+
+	isMarkedForDeletion bool
+}
+
+// probabilisticSelectionPolicy is the default TransactionSelectionPolicy. It selects
+// transactions at random, weighted by their fee rates. See SelectTransactions for
+// further details.
+type probabilisticSelectionPolicy struct{}
+
+// NewProbabilisticSelectionPolicy creates a new probabilistic TransactionSelectionPolicy
+func NewProbabilisticSelectionPolicy() miningmanagerapi.TransactionSelectionPolicy {
+	return &probabilisticSelectionPolicy{}
 }
 
-// selectTransactions implements a probabilistic transaction selection algorithm.
+// SelectTransactions implements a probabilistic transaction selection algorithm.
 // The algorithm, roughly, is as follows:
 // 1. We assign a probability to each transaction equal to:
 //    (candidateTx.Value^alpha) / Σ(tx.Value^alpha)
@@ -51,19 +66,26 @@ type selectedTransactions struct {
 //   Once the sum of probabilities of marked transactions is greater than
 //   rebalanceThreshold percent of the sum of probabilities of all transactions,
 //   rebalance.
-
-// selectTransactions loops over the candidate transactions
-// and appends the ones that will be included in the next block into
-// txsForBlockTemplates.
-// See selectTxs for further details.
-func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx) selectedTransactions {
-	txsForBlockTemplate := selectedTransactions{
-		selectedTxs: make([]*consensusexternalapi.DomainTransaction, 0, len(candidateTxs)),
-		txMasses:    make([]uint64, 0, len(candidateTxs)),
-		txFees:      make([]uint64, 0, len(candidateTxs)),
-		totalMass:   0,
-		totalFees:   0,
+func (*probabilisticSelectionPolicy) SelectTransactions(mempoolTransactions []*consensusexternalapi.DomainTransaction,
+	blockMaxMass uint64) []*consensusexternalapi.DomainTransaction {
+
+	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
+	for _, tx := range mempoolTransactions {
+		// Calculate the tx value
+		gasLimit := uint64(0)
+		candidateTxs = append(candidateTxs, &candidateTx{
+			DomainTransaction: tx,
+			txValue:           calcTxValue(tx, blockMaxMass),
+			gasLimit:          gasLimit,
+		})
 	}
+
+	// Sort the candidate txs by subnetworkID.
+	sort.Slice(candidateTxs, func(i, j int) bool {
+		return subnetworks.Less(candidateTxs[i].SubnetworkID, candidateTxs[j].SubnetworkID)
+	})
+
+	totalMass := uint64(0)
 	usedCount, usedP := 0, 0.0
 	candidateTxs, totalP := rebalanceCandidates(candidateTxs, true)
 	gasUsageMap := make(map[consensusexternalapi.DomainSubnetworkID]uint64)
@@ -74,7 +96,7 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 		usedP += candidateTx.p
 	}
 
-	selectedTxs := make([]*candidateTx, 0)
+	selectedTxs := make([]*consensusexternalapi.DomainTransaction, 0)
 	for len(candidateTxs)-usedCount > 0 {
 		// Rebalance the candidates if it's required
 		if usedP >= rebalanceThreshold*totalP {
@@ -101,8 +123,8 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 
 		// Enforce maximum transaction mass per block. Also check
 		// for overflow.
-		if txsForBlockTemplate.totalMass+selectedTx.Mass < txsForBlockTemplate.totalMass ||
-			txsForBlockTemplate.totalMass+selectedTx.Mass > btb.policy.BlockMaxMass {
+		if totalMass+selectedTx.Mass < totalMass ||
+			totalMass+selectedTx.Mass > blockMaxMass {
 			log.Tracef("Tx %s would exceed the max block mass. "+
 				"As such, stopping.", consensushashing.TransactionID(tx))
 			break
@@ -139,12 +161,9 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 			gasUsageMap[subnetworkID] = gasUsage + txGas
 		}
 
-		// Add the transaction to the result, increment counters, and
-		// save the masses, fees, and signature operation counts to the
-		// result.
-		selectedTxs = append(selectedTxs, selectedTx)
-		txsForBlockTemplate.totalMass += selectedTx.Mass
-		txsForBlockTemplate.totalFees += selectedTx.Fee
+		// Add the transaction to the result and increment the mass counter
+		selectedTxs = append(selectedTxs, selectedTx.DomainTransaction)
+		totalMass += selectedTx.Mass
 
 		log.Tracef("Adding tx %s (feePerMegaGram %d)",
 			consensushashing.TransactionID(tx), selectedTx.Fee*1e6/selectedTx.Mass)
@@ -152,15 +171,7 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 		markCandidateTxForDeletion(selectedTx)
 	}
 
-	sort.Slice(selectedTxs, func(i, j int) bool {
-		return subnetworks.Less(selectedTxs[i].SubnetworkID, selectedTxs[j].SubnetworkID)
-	})
-	for _, selectedTx := range selectedTxs {
-		txsForBlockTemplate.selectedTxs = append(txsForBlockTemplate.selectedTxs, selectedTx.DomainTransaction)
-		txsForBlockTemplate.txMasses = append(txsForBlockTemplate.txMasses, selectedTx.Mass)
-		txsForBlockTemplate.txFees = append(txsForBlockTemplate.txFees, selectedTx.Fee)
-	}
-	return txsForBlockTemplate
+	return selectedTxs
 }
 
 func rebalanceCandidates(oldCandidateTxs []*candidateTx, isFirstRun bool) (
@@ -211,3 +222,19 @@ func findTx(candidateTxs []*candidateTx, r float64) *candidateTx {
 		return candidateTx
 	}
 }
+
+// calcTxValue calculates a value to be used in transaction selection.
+// The higher the number the more likely it is that the transaction will be
+// included in the block.
+func calcTxValue(tx *consensusexternalapi.DomainTransaction, blockMaxMass uint64) float64 {
+	massLimit := blockMaxMass
+
+	mass := tx.Mass
+	fee := tx.Fee
+	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
+		return float64(fee) / (float64(mass) / float64(massLimit))
+	}
+	// TODO: Replace with real gas once implemented
+	gasLimit := uint64(math.MaxUint64)
+	return float64(fee) / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
+}
@@ -8,28 +8,36 @@ import (
 	"github.com/shatll-s/nexelliad/domain/dagconfig"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/blocktemplatebuilder"
 	mempoolpkg "github.com/shatll-s/nexelliad/domain/miningmanager/mempool"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 )
 
 // Factory instantiates new mining managers
 type Factory interface {
-	NewMiningManager(consensus consensusreference.ConsensusReference, params *dagconfig.Params, mempoolConfig *mempoolpkg.Config) MiningManager
+	NewMiningManager(consensus consensusreference.ConsensusReference, params *dagconfig.Params, mempoolConfig *mempoolpkg.Config,
+		mempoolEventsChan chan<- miningmanagermodel.MempoolEvent) MiningManager
 }
 
 type factory struct{}
 
 // NewMiningManager instantiate a new mining manager
 func (f *factory) NewMiningManager(consensusReference consensusreference.ConsensusReference, params *dagconfig.Params,
-	mempoolConfig *mempoolpkg.Config) MiningManager {
+	mempoolConfig *mempoolpkg.Config, mempoolEventsChan chan<- miningmanagermodel.MempoolEvent) MiningManager {
 
-	mempool := mempoolpkg.New(mempoolConfig, consensusReference)
-	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, params.MaxBlockMass, params.CoinbasePayloadScriptPublicKeyMaxLength)
+	mempool := mempoolpkg.New(mempoolConfig, consensusReference, mempoolEventsChan)
+	selectionPolicy := blocktemplatebuilder.NewProbabilisticSelectionPolicy()
+	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, selectionPolicy,
+		params.MaxBlockMass, params.CoinbasePayloadScriptPublicKeyMaxLength)
 
 	return &miningManager{
 		consensusReference:   consensusReference,
 		mempool:              mempool,
 		blockTemplateBuilder: blockTemplateBuilder,
+		selectionPolicy:      selectionPolicy,
 		cachingTime:          time.Time{},
 		cacheLock:            &sync.Mutex{},
+
+		recentTemplateIDs:        newRecentTemplateIDs(),
+		blockTemplateClearedChan: make(chan struct{}),
 	}
 }
 
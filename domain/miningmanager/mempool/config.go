@@ -27,6 +27,12 @@ const (
 	// the mempool and relayed. It is specified in sompi per 1kg (or 1000 grams) of transaction mass.
 	defaultMinimumRelayTransactionFee = util.Amount(1000)
 
+	// defaultNormalFeeEstimateTargetSeconds and defaultLowFeeEstimateTargetSeconds are the
+	// times within which transactions that pay the normal and low estimated fee rates are
+	// expected to be mined
+	defaultNormalFeeEstimateTargetSeconds = 60
+	defaultLowFeeEstimateTargetSeconds    = 60 * 60
+
 	// Standard transaction version range might be different from what consensus accepts, therefore
 	// we define separate values in mempool.
 	// However, currently there's exactly one transaction version, so mempool accepts the same version
@@ -50,6 +56,8 @@ type Config struct {
 	MinimumRelayTransactionFee            util.Amount
 	MinimumStandardTransactionVersion     uint16
 	MaximumStandardTransactionVersion     uint16
+	NormalFeeEstimateTargetBlocks         uint64
+	LowFeeEstimateTargetBlocks            uint64
 }
 
 // DefaultConfig returns the default mempool configuration
@@ -70,5 +78,7 @@ func DefaultConfig(dagParams *dagconfig.Params) *Config {
 		MinimumRelayTransactionFee:            defaultMinimumRelayTransactionFee,
 		MinimumStandardTransactionVersion:     defaultMinimumStandardTransactionVersion,
 		MaximumStandardTransactionVersion:     defaultMaximumStandardTransactionVersion,
+		NormalFeeEstimateTargetBlocks:         uint64(defaultNormalFeeEstimateTargetSeconds * targetBlocksPerSecond),
+		LowFeeEstimateTargetBlocks:            uint64(defaultLowFeeEstimateTargetSeconds * targetBlocksPerSecond),
 	}
 }
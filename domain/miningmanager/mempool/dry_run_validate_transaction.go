@@ -0,0 +1,129 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+	"github.com/pkg/errors"
+)
+
+// dryRunValidateTransaction runs the same validation as validateAndInsertTransaction
+// (with allowOrphan = false), but instead of inserting the transaction it reports
+// everything that was found about it
+func (mp *mempool) dryRunValidateTransaction(transaction *externalapi.DomainTransaction) (
+	*miningmanagermodel.TransactionValidationResult, error) {
+
+	// Validation populates the transaction with mass, fee and UTXO entries, so we
+	// work on a copy in order to leave the caller's transaction untouched
+	transaction = transaction.Clone()
+	mp.consensusReference.Consensus().PopulateMass(transaction)
+
+	result := &miningmanagermodel.TransactionValidationResult{Mass: transaction.Mass}
+
+	// Standardness is reported even when the node is configured to accept
+	// non-standard transactions, since the transaction might be relayed to
+	// nodes that aren't
+	err := mp.checkTransactionStandardInIsolation(transaction)
+	if err != nil {
+		result.StandardnessViolations = append(result.StandardnessViolations, err.Error())
+	}
+
+	rejectErr := mp.validateTransactionPreUTXOEntry(transaction)
+
+	// Inputs are filled even if the transaction was already rejected, so
+	// that the fee and the input scripts could still be reported
+	_, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
+	if err != nil {
+		if !errors.As(err, &RuleError{}) {
+			return nil, err
+		}
+		if rejectErr == nil {
+			rejectErr = err
+		}
+	}
+	if rejectErr == nil && len(missingOutpoints) > 0 {
+		rejectErr = transactionRuleError(RejectBadOrphan,
+			fmt.Sprintf("Transaction %s is an orphan", consensushashing.TransactionID(transaction)))
+	}
+
+	result.InputScriptResults = verifyInputScripts(transaction)
+
+	if areAllInputsFilled(transaction) {
+		fee, ok := calculateFee(transaction)
+		if ok {
+			transaction.Fee = fee
+			result.Fee = fee
+			if transaction.Mass > 0 {
+				result.FeeRate = float64(fee) / float64(transaction.Mass)
+			}
+
+			err = mp.checkTransactionStandardInContext(transaction)
+			if err != nil {
+				result.StandardnessViolations = append(result.StandardnessViolations, err.Error())
+			}
+		}
+
+		if rejectErr == nil {
+			rejectErr = mp.validateTransactionInContext(transaction)
+		}
+	}
+
+	if rejectErr != nil {
+		result.RejectReason = rejectErr.Error()
+	}
+	return result, nil
+}
+
+func areAllInputsFilled(transaction *externalapi.DomainTransaction) bool {
+	for _, input := range transaction.Inputs {
+		if input.UTXOEntry == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// calculateFee returns the fee paid by a transaction whose inputs are all
+// filled, and false if its outputs spend more than its inputs
+func calculateFee(transaction *externalapi.DomainTransaction) (uint64, bool) {
+	var totalIn, totalOut uint64
+	for _, input := range transaction.Inputs {
+		totalIn += input.UTXOEntry.Amount()
+	}
+	for _, output := range transaction.Outputs {
+		totalOut += output.Value
+	}
+	if totalIn < totalOut {
+		return 0, false
+	}
+	return totalIn - totalOut, true
+}
+
+func verifyInputScripts(transaction *externalapi.DomainTransaction) []*miningmanagermodel.InputScriptResult {
+	results := make([]*miningmanagermodel.InputScriptResult, len(transaction.Inputs))
+	sighashReusedValues := &consensushashing.SighashReusedValues{}
+	for i, input := range transaction.Inputs {
+		if input.UTXOEntry == nil {
+			results[i] = &miningmanagermodel.InputScriptResult{
+				Error: fmt.Sprintf("outpoint %s:%d was not found",
+					input.PreviousOutpoint.TransactionID, input.PreviousOutpoint.Index),
+			}
+			continue
+		}
+
+		vm, err := txscript.NewEngine(input.UTXOEntry.ScriptPublicKey(), transaction, i,
+			txscript.ScriptNoFlags, nil, nil, sighashReusedValues)
+		if err == nil {
+			err = vm.Execute()
+		}
+		if err != nil {
+			results[i] = &miningmanagermodel.InputScriptResult{Error: err.Error()}
+			continue
+		}
+		results[i] = &miningmanagermodel.InputScriptResult{IsValid: true}
+	}
+	return results
+}
@@ -0,0 +1,119 @@
+package mempool
+
+import (
+	"math"
+	"sort"
+
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+)
+
+// feeRateBucketBase is the ratio between the fee rates that bound consecutive
+// buckets. Estimates are rounded up to a bucket bound, so they are at most
+// 10% higher than strictly required
+const feeRateBucketBase = 1.1
+
+// feeRateBucket holds the total mass of the mempool transactions whose fee
+// rate falls within [feeRateBucketBase^index, feeRateBucketBase^(index+1))
+type feeRateBucket struct {
+	index int
+	mass  uint64
+}
+
+func (b *feeRateBucket) lowerBound() float64 {
+	return math.Pow(feeRateBucketBase, float64(b.index))
+}
+
+func (b *feeRateBucket) upperBound() float64 {
+	return math.Pow(feeRateBucketBase, float64(b.index+1))
+}
+
+// feeRateBuckets are the mempool transactions bucketed by fee rate,
+// ordered from the highest fee rate to the lowest
+type feeRateBuckets []*feeRateBucket
+
+func (mp *mempool) EstimateFees() *miningmanagermodel.FeeEstimate {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	buckets := mp.transactionsPool.feeRateBuckets()
+	minimumFeeRate := float64(mp.config.MinimumRelayTransactionFee) / 1000
+
+	bucketForTargetBlocks := func(targetBlocks uint64) miningmanagermodel.FeeEstimateBucket {
+		feeRate := buckets.feeRateForBlocks(targetBlocks, mp.config.MaximumMassPerBlock)
+		if feeRate < minimumFeeRate {
+			feeRate = minimumFeeRate
+		}
+		return miningmanagermodel.FeeEstimateBucket{
+			FeeRate:         feeRate,
+			EstimatedBlocks: buckets.blocksForFeeRate(feeRate, mp.config.MaximumMassPerBlock),
+		}
+	}
+
+	return &miningmanagermodel.FeeEstimate{
+		Priority: bucketForTargetBlocks(1),
+		Normal:   bucketForTargetBlocks(mp.config.NormalFeeEstimateTargetBlocks),
+		Low:      bucketForTargetBlocks(mp.config.LowFeeEstimateTargetBlocks),
+	}
+}
+
+func (tp *transactionsPool) feeRateBuckets() feeRateBuckets {
+	bucketsByIndex := make(map[int]*feeRateBucket)
+	for _, mempoolTransaction := range tp.allTransactions {
+		transaction := mempoolTransaction.Transaction()
+		// Transactions that pay no fee are never selected over ones that do
+		if transaction.Fee == 0 || transaction.Mass == 0 {
+			continue
+		}
+		feeRate := float64(transaction.Fee) / float64(transaction.Mass)
+		index := int(math.Floor(math.Log(feeRate) / math.Log(feeRateBucketBase)))
+		bucket, ok := bucketsByIndex[index]
+		if !ok {
+			bucket = &feeRateBucket{index: index}
+			bucketsByIndex[index] = bucket
+		}
+		bucket.mass += transaction.Mass
+	}
+
+	buckets := make(feeRateBuckets, 0, len(bucketsByIndex))
+	for _, bucket := range bucketsByIndex {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].index > buckets[j].index
+	})
+	return buckets
+}
+
+// feeRateForBlocks simulates filling the next targetBlocks blocks with the
+// highest paying transactions first, and returns the fee rate a transaction
+// needs in order to outbid the ones that don't fit. Zero is returned if all
+// the transactions fit.
+//
+// The block template builder selects transactions at random, weighted by their
+// fee rate, so this is somewhat pessimistic: some transactions that pay less
+// are expected to make it into these blocks as well
+func (buckets feeRateBuckets) feeRateForBlocks(targetBlocks uint64, maximumMassPerBlock uint64) float64 {
+	massCapacity := targetBlocks * maximumMassPerBlock
+	accumulatedMass := uint64(0)
+	for _, bucket := range buckets {
+		accumulatedMass += bucket.mass
+		if accumulatedMass > massCapacity {
+			return bucket.upperBound()
+		}
+	}
+	return 0
+}
+
+// blocksForFeeRate returns the number of blocks a transaction that pays the
+// given fee rate is expected to wait, given that every transaction in the
+// buckets above it is selected first
+func (buckets feeRateBuckets) blocksForFeeRate(feeRate float64, maximumMassPerBlock uint64) uint64 {
+	massAhead := uint64(0)
+	for _, bucket := range buckets {
+		if bucket.lowerBound() < feeRate {
+			break
+		}
+		massAhead += bucket.mass
+	}
+	return massAhead/maximumMassPerBlock + 1
+}
@@ -0,0 +1,73 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+)
+
+func TestEstimateFees(t *testing.T) {
+	mp := &mempool{
+		config: &Config{
+			MaximumMassPerBlock:           1000,
+			MinimumRelayTransactionFee:    1000,
+			NormalFeeEstimateTargetBlocks: 3,
+			LowFeeEstimateTargetBlocks:    10,
+		},
+	}
+	mp.transactionsPool = &transactionsPool{mempool: mp, allTransactions: model.IDToTransactionMap{}}
+
+	estimate := mp.EstimateFees()
+	for name, feeRate := range map[string]float64{
+		"priority": estimate.Priority.FeeRate, "normal": estimate.Normal.FeeRate, "low": estimate.Low.FeeRate} {
+		if feeRate != 1 {
+			t.Errorf("Unexpected %s fee rate for an empty mempool. Want: 1, got: %f", name, feeRate)
+		}
+	}
+	if estimate.Priority.EstimatedBlocks != 1 {
+		t.Errorf("Unexpected estimated blocks for an empty mempool. Want: 1, got: %d", estimate.Priority.EstimatedBlocks)
+	}
+
+	lockTime := uint64(0)
+	addTransactions := func(count int, fee uint64, mass uint64) {
+		for i := 0; i < count; i++ {
+			lockTime++
+			transaction := &externalapi.DomainTransaction{LockTime: lockTime, Fee: fee, Mass: mass}
+			mempoolTransaction := model.NewMempoolTransaction(transaction, model.IDToTransactionMap{}, false, 0)
+			mp.transactionsPool.allTransactions[*mempoolTransaction.TransactionID()] = mempoolTransaction
+		}
+	}
+	// 2.5 blocks worth of transactions that pay 100 sompi per gram,
+	// and 5 blocks worth of transactions that pay 10 sompi per gram
+	addTransactions(5, 50_000, 500)
+	addTransactions(10, 5_000, 500)
+	// Transactions that pay no fee don't affect the estimate
+	addTransactions(100, 0, 500)
+
+	estimate = mp.EstimateFees()
+	tests := []struct {
+		name                    string
+		feeRate                 float64
+		estimatedBlocks         uint64
+		minFeeRate, maxFeeRate  float64
+		expectedEstimatedBlocks uint64
+	}{
+		{name: "priority", feeRate: estimate.Priority.FeeRate, estimatedBlocks: estimate.Priority.EstimatedBlocks,
+			minFeeRate: 100, maxFeeRate: 110, expectedEstimatedBlocks: 1},
+		{name: "normal", feeRate: estimate.Normal.FeeRate, estimatedBlocks: estimate.Normal.EstimatedBlocks,
+			minFeeRate: 10, maxFeeRate: 11, expectedEstimatedBlocks: 3},
+		{name: "low", feeRate: estimate.Low.FeeRate, estimatedBlocks: estimate.Low.EstimatedBlocks,
+			minFeeRate: 1, maxFeeRate: 1, expectedEstimatedBlocks: 8},
+	}
+	for _, test := range tests {
+		if test.feeRate < test.minFeeRate || test.feeRate > test.maxFeeRate {
+			t.Errorf("Unexpected %s fee rate. Want: between %f and %f, got: %f",
+				test.name, test.minFeeRate, test.maxFeeRate, test.feeRate)
+		}
+		if test.estimatedBlocks != test.expectedEstimatedBlocks {
+			t.Errorf("Unexpected %s estimated blocks. Want: %d, got: %d",
+				test.name, test.expectedEstimatedBlocks, test.estimatedBlocks)
+		}
+	}
+}
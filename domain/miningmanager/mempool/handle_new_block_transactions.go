@@ -4,6 +4,7 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/transactionhelper"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 )
 
 func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.DomainTransaction) (
@@ -15,7 +16,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 	acceptedOrphans := []*externalapi.DomainTransaction{}
 	for _, transaction := range blockTransactions {
 		transactionID := consensushashing.TransactionID(transaction)
-		err := mp.removeTransaction(transactionID, false)
+		err := mp.removeTransaction(transactionID, false, miningmanagermodel.RemovalReasonAcceptedInBlock)
 		if err != nil {
 			return nil, err
 		}
@@ -25,7 +26,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 			return nil, err
 		}
 
-		err = mp.orphansPool.removeOrphan(transactionID, false)
+		err = mp.orphansPool.removeOrphan(transactionID, false, miningmanagermodel.RemovalReasonAcceptedInBlock)
 		if err != nil {
 			return nil, err
 		}
@@ -52,7 +53,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 func (mp *mempool) removeDoubleSpends(transaction *externalapi.DomainTransaction) error {
 	for _, input := range transaction.Inputs {
 		if redeemer, ok := mp.mempoolUTXOSet.transactionByPreviousOutpoint[input.PreviousOutpoint]; ok {
-			err := mp.removeTransaction(redeemer.TransactionID(), true)
+			err := mp.removeTransaction(redeemer.TransactionID(), true, miningmanagermodel.RemovalReasonDoubleSpent)
 			if err != nil {
 				return err
 			}
@@ -21,13 +21,20 @@ type mempool struct {
 	mempoolUTXOSet   *mempoolUTXOSet
 	transactionsPool *transactionsPool
 	orphansPool      *orphansPool
+
+	eventsChan                chan<- miningmanagermodel.MempoolEvent
+	transactionRemovedHandler miningmanagermodel.TransactionRemovedHandler
 }
 
-// New constructs a new mempool
-func New(config *Config, consensusReference consensusreference.ConsensusReference) miningmanagermodel.Mempool {
+// New constructs a new mempool. If eventsChan is not nil, the mempool
+// sends it an event whenever a transaction is added or removed
+func New(config *Config, consensusReference consensusreference.ConsensusReference,
+	eventsChan chan<- miningmanagermodel.MempoolEvent) miningmanagermodel.Mempool {
+
 	mp := &mempool{
 		config:             config,
 		consensusReference: consensusReference,
+		eventsChan:         eventsChan,
 	}
 
 	mp.mempoolUTXOSet = newMempoolUTXOSet(mp)
@@ -46,6 +53,15 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+func (mp *mempool) ValidateTransaction(transaction *externalapi.DomainTransaction) (
+	*miningmanagermodel.TransactionValidationResult, error) {
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.dryRunValidateTransaction(transaction)
+}
+
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
 	includeOrphanPool bool) (
@@ -208,12 +224,21 @@ func (mp *mempool) RemoveTransactions(transactions []*externalapi.DomainTransact
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.removeTransactions(transactions, removeRedeemers)
+	return mp.removeTransactions(transactions, removeRedeemers, miningmanagermodel.RemovalReasonInvalid)
 }
 
 func (mp *mempool) RemoveTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.removeTransaction(transactionID, removeRedeemers)
+	return mp.removeTransaction(transactionID, removeRedeemers, miningmanagermodel.RemovalReasonInvalid)
+}
+
+// SetTransactionRemovedHandler sets the handler that is called whenever a transaction leaves
+// the mempool, replacing the previous one. A nil handler removes the previous one
+func (mp *mempool) SetTransactionRemovedHandler(handler miningmanagermodel.TransactionRemovedHandler) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.transactionRemovedHandler = handler
 }
@@ -0,0 +1,43 @@
+package mempool
+
+import (
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
+	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+)
+
+func (mp *mempool) sendTransactionAddedEvent(transaction model.Transaction, isOrphan bool) {
+	mp.sendEvent(&miningmanagermodel.TransactionAddedToMempool{
+		Transaction: transaction.Transaction().Clone(), //these pointers leave the mempool, hence the clone
+		IsOrphan:    isOrphan,
+	})
+}
+
+// sendTransactionRemovedEvent sends a TransactionRemovedFromMempool event, and also calls the
+// transaction removed handler unless the transaction is an orphan that moves to the transaction pool
+func (mp *mempool) sendTransactionRemovedEvent(transaction model.Transaction, isOrphan bool,
+	reason miningmanagermodel.TransactionRemovalReason) {
+
+	if mp.transactionRemovedHandler != nil && reason != miningmanagermodel.RemovalReasonOrphanPromoted {
+		mp.transactionRemovedHandler(transaction.TransactionID())
+	}
+
+	mp.sendEvent(&miningmanagermodel.TransactionRemovedFromMempool{
+		Transaction: transaction.Transaction().Clone(), //these pointers leave the mempool, hence the clone
+		IsOrphan:    isOrphan,
+		Reason:      reason,
+	})
+}
+
+// sendEvent sends the given event to the mempool events channel, if there is one.
+// Unlike consensus events, mempool events are dropped when the channel is full,
+// since no listener should be able to make the mempool reject transactions
+func (mp *mempool) sendEvent(event miningmanagermodel.MempoolEvent) {
+	if mp.eventsChan == nil {
+		return
+	}
+	if len(mp.eventsChan) == cap(mp.eventsChan) {
+		log.Warnf("Mempool events channel is full. Dropping a %T event", event)
+		return
+	}
+	mp.eventsChan <- event
+}
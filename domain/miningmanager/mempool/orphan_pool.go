@@ -13,6 +13,7 @@ import (
 
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 	"github.com/pkg/errors"
 )
 
@@ -80,7 +81,7 @@ func (op *orphansPool) limitOrphanPoolSize() error {
 
 		// Don't remove redeemers in the case of a random eviction since the evicted transaction is
 		// not invalid, therefore it's redeemers are as good as any orphan that just arrived.
-		err := op.removeOrphan(orphanToRemove.TransactionID(), false)
+		err := op.removeOrphan(orphanToRemove.TransactionID(), false, miningmanagermodel.RemovalReasonEvicted)
 		if err != nil {
 			return err
 		}
@@ -131,6 +132,8 @@ func (op *orphansPool) addOrphan(transaction *externalapi.DomainTransaction, isH
 		op.orphansByPreviousOutpoint[input.PreviousOutpoint] = orphanTransaction
 	}
 
+	op.mempool.sendTransactionAddedEvent(orphanTransaction, true)
+
 	return nil
 }
 
@@ -188,23 +191,16 @@ func countUnfilledInputs(orphan *model.OrphanTransaction) int {
 }
 
 func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction) error {
-	err := op.removeOrphan(transaction.TransactionID(), false)
-	if err != nil {
-		return err
-	}
-
-	err = op.mempool.consensusReference.Consensus().ValidateTransactionAndPopulateWithConsensusData(transaction.Transaction())
+	err := op.validateUnorphanedTransaction(transaction)
 	if err != nil {
-		if errors.Is(err, ruleerrors.ErrImmatureSpend) {
-			return transactionRuleError(RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
-		}
-		if errors.As(err, &ruleerrors.RuleError{}) {
-			return newRuleError(err)
+		removeErr := op.removeOrphan(transaction.TransactionID(), false, miningmanagermodel.RemovalReasonInvalid)
+		if removeErr != nil {
+			return removeErr
 		}
 		return err
 	}
 
-	err = op.mempool.validateTransactionInContext(transaction.Transaction())
+	err = op.removeOrphan(transaction.TransactionID(), false, miningmanagermodel.RemovalReasonOrphanPromoted)
 	if err != nil {
 		return err
 	}
@@ -227,7 +223,25 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 	return nil
 }
 
-func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+// validateUnorphanedTransaction validates an orphan whose inputs have all been filled
+func (op *orphansPool) validateUnorphanedTransaction(transaction *model.OrphanTransaction) error {
+	err := op.mempool.consensusReference.Consensus().ValidateTransactionAndPopulateWithConsensusData(transaction.Transaction())
+	if err != nil {
+		if errors.Is(err, ruleerrors.ErrImmatureSpend) {
+			return transactionRuleError(RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
+		}
+		if errors.As(err, &ruleerrors.RuleError{}) {
+			return newRuleError(err)
+		}
+		return err
+	}
+
+	return op.mempool.validateTransactionInContext(transaction.Transaction())
+}
+
+func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	orphanTransaction, ok := op.allOrphans[*orphanTransactionID]
 	if !ok {
 		return nil
@@ -242,9 +256,10 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 		}
 		delete(op.orphansByPreviousOutpoint, input.PreviousOutpoint)
 	}
+	op.mempool.sendTransactionRemovedEvent(orphanTransaction, true, reason)
 
 	if removeRedeemers {
-		err := op.removeRedeemersOf(orphanTransaction)
+		err := op.removeRedeemersOf(orphanTransaction, reason)
 		if err != nil {
 			return err
 		}
@@ -253,13 +268,15 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 	return nil
 }
 
-func (op *orphansPool) removeRedeemersOf(transaction model.Transaction) error {
+func (op *orphansPool) removeRedeemersOf(transaction model.Transaction,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	outpoint := externalapi.DomainOutpoint{TransactionID: *transaction.TransactionID()}
 	for i := range transaction.Transaction().Outputs {
 		outpoint.Index = uint32(i)
 		if orphan, ok := op.orphansByPreviousOutpoint[outpoint]; ok {
 			// Recursive call is bound by size of orphan pool (which is very small)
-			err := op.removeOrphan(orphan.TransactionID(), true)
+			err := op.removeOrphan(orphan.TransactionID(), true, reason)
 			if err != nil {
 				return err
 			}
@@ -286,7 +303,7 @@ func (op *orphansPool) expireOrphanTransactions() error {
 
 		// Remove all transactions whose addedAtDAAScore is older then TransactionExpireIntervalDAAScore
 		if virtualDAAScore-orphanTransaction.AddedAtDAAScore() > op.mempool.config.OrphanExpireIntervalDAAScore {
-			err = op.removeOrphan(orphanTransaction.TransactionID(), false)
+			err = op.removeOrphan(orphanTransaction.TransactionID(), false, miningmanagermodel.RemovalReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -298,10 +315,11 @@ func (op *orphansPool) expireOrphanTransactions() error {
 }
 
 func (op *orphansPool) updateOrphansAfterTransactionRemoved(
-	removedTransaction *model.MempoolTransaction, removeRedeemers bool) error {
+	removedTransaction *model.MempoolTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
 
 	if removeRedeemers {
-		return op.removeRedeemersOf(removedTransaction)
+		return op.removeRedeemersOf(removedTransaction, reason)
 	}
 
 	outpoint := externalapi.DomainOutpoint{TransactionID: *removedTransaction.TransactionID()}
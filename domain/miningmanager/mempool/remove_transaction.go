@@ -4,11 +4,14 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 )
 
-func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	for _, transaction := range transactions {
-		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers)
+		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
@@ -16,9 +19,13 @@ func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransact
 	return nil
 }
 
-func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+// removeTransaction removes the given transaction from the mempool. If removeRedeemers is
+// true, the transactions that spend its outputs are removed as well, for the same reason
+func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
-		return mp.orphansPool.removeOrphan(transactionID, true)
+		return mp.orphansPool.removeOrphan(transactionID, true, reason)
 	}
 
 	mempoolTransaction, ok := mp.transactionsPool.allTransactions[*transactionID]
@@ -37,14 +44,14 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 	}
 
 	for _, transactionToRemove := range transactionsToRemove {
-		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers)
+		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
 	}
 
 	if removeRedeemers {
-		err := mp.orphansPool.removeRedeemersOf(mempoolTransaction)
+		err := mp.orphansPool.removeRedeemersOf(mempoolTransaction, reason)
 		if err != nil {
 			return err
 		}
@@ -53,15 +60,18 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 	return nil
 }
 
-func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	mp.mempoolUTXOSet.removeTransaction(mempoolTransaction)
 
 	err := mp.transactionsPool.removeTransaction(mempoolTransaction)
 	if err != nil {
 		return err
 	}
+	mp.sendTransactionRemovedEvent(mempoolTransaction, false, reason)
 
-	err = mp.orphansPool.updateOrphansAfterTransactionRemoved(mempoolTransaction, removeRedeemers)
+	err = mp.orphansPool.updateOrphansAfterTransactionRemoved(mempoolTransaction, removeRedeemers, reason)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package mempool
 import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 	"github.com/shatll-s/nexelliad/infrastructure/logger"
 )
 
@@ -35,7 +36,7 @@ func (mp *mempool) revalidateTransaction(transaction *model.MempoolTransaction)
 	}
 	if len(missingParents) > 0 {
 		log.Debugf("Removing transaction %s, it failed revalidation", transaction.TransactionID())
-		err := mp.removeTransaction(transaction.TransactionID(), true)
+		err := mp.removeTransaction(transaction.TransactionID(), true, miningmanagermodel.RemovalReasonInvalid)
 		if err != nil {
 			return false, err
 		}
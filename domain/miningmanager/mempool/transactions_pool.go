@@ -8,6 +8,7 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
 	"github.com/shatll-s/nexelliad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/shatll-s/nexelliad/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -74,6 +75,8 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 		tp.highPriorityTransactions[*transaction.TransactionID()] = transaction
 	}
 
+	tp.mempool.sendTransactionAddedEvent(transaction, false)
+
 	return nil
 }
 
@@ -119,7 +122,8 @@ func (tp *transactionsPool) expireOldTransactions() error {
 		if daaScoreSinceAdded > tp.mempool.config.TransactionExpireIntervalDAAScore {
 			log.Debugf("Removing transaction %s, because it expired. DAAScore moved by %d, expire interval: %d",
 				mempoolTransaction.TransactionID(), daaScoreSinceAdded, tp.mempool.config.TransactionExpireIntervalDAAScore)
-			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true)
+			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true,
+				miningmanagermodel.RemovalReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -194,7 +198,8 @@ func (tp *transactionsPool) limitTransactionCount() error {
 
 		log.Debugf("Removing transaction %s, because mempoolTransaction count (%d) exceeded the limit (%d)",
 			transactionToRemove.TransactionID(), len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true)
+		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true,
+			miningmanagermodel.RemovalReasonEvicted)
 		if err != nil {
 			return err
 		}
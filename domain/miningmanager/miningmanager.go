@@ -12,9 +12,14 @@ import (
 // MiningManager creates block templates for mining as well as maintaining
 // known transactions that have no yet been added to any block
 type MiningManager interface {
-	GetBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (block *externalapi.DomainBlock, isNearlySynced bool, err error)
+	GetBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (block *externalapi.DomainBlock,
+		templateID *externalapi.DomainHash, isNearlySynced bool, err error)
 	ClearBlockTemplate()
+	BlockTemplateCleared() <-chan struct{}
+	IsBlockTemplateStale(templateID *externalapi.DomainHash) bool
 	GetBlockTemplateBuilder() miningmanagermodel.BlockTemplateBuilder
+	SetTransactionSelectionPolicy(selectionPolicy miningmanagermodel.TransactionSelectionPolicy)
+	TransactionSelectionPolicy() miningmanagermodel.TransactionSelectionPolicy
 	GetTransaction(transactionID *externalapi.DomainTransactionID, includeTransactionPool bool, includeOrphanPool bool) (
 		transactionPoolTransaction *externalapi.DomainTransaction,
 		isOrphan bool,
@@ -32,7 +37,9 @@ type MiningManager interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateTransaction(transaction *externalapi.DomainTransaction) (*miningmanagermodel.TransactionValidationResult, error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	GetFeeEstimate() *miningmanagermodel.FeeEstimate
 }
 
 type miningManager struct {
@@ -40,49 +47,85 @@ type miningManager struct {
 	mempool              miningmanagermodel.Mempool
 	blockTemplateBuilder miningmanagermodel.BlockTemplateBuilder
 	cachedBlockTemplate  *externalapi.DomainBlockTemplate
+	cachedTemplateID     *externalapi.DomainHash
+	selectionPolicy      miningmanagermodel.TransactionSelectionPolicy
 	cachingTime          time.Time
 	cacheLock            *sync.Mutex
+
+	// recentTemplateIDs are the IDs of the templates built since the block template
+	// was last cleared. Templates with any other ID are stale
+	recentTemplateIDs        *recentTemplateIDs
+	blockTemplateClearedChan chan struct{}
 }
 
-// GetBlockTemplate obtains a block template for a miner to consume
-func (mm *miningManager) GetBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (block *externalapi.DomainBlock, isNearlySynced bool, err error) {
+// GetBlockTemplate obtains a block template for a miner to consume, along with the ID
+// of the work in it, which is the same for all coinbase data
+func (mm *miningManager) GetBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (
+	block *externalapi.DomainBlock, templateID *externalapi.DomainHash, isNearlySynced bool, err error) {
+
 	mm.cacheLock.Lock()
 	immutableCachedTemplate := mm.getImmutableCachedTemplate()
 	// We first try and use a cached template
-	if immutableCachedTemplate != nil {
+	// A coinbase that splits its rewards between payouts may have a different number of outputs,
+	// so templates are only modified in place when neither the cached nor the requested
+	// coinbase data has payouts. Otherwise, we build (and cache) a new template
+	if immutableCachedTemplate != nil && (immutableCachedTemplate.CoinbaseData.Equal(coinbaseData) ||
+		(len(immutableCachedTemplate.CoinbaseData.Payouts) == 0 && len(coinbaseData.Payouts) == 0)) {
+
+		cachedTemplateID := mm.cachedTemplateID
 		mm.cacheLock.Unlock()
 		if immutableCachedTemplate.CoinbaseData.Equal(coinbaseData) {
-			return immutableCachedTemplate.Block, immutableCachedTemplate.IsNearlySynced, nil
+			return immutableCachedTemplate.Block, cachedTemplateID, immutableCachedTemplate.IsNearlySynced, nil
 		}
 		// Coinbase data is new -- make the minimum changes required
 		// Note we first clone the block template since it is modified by the call
 		modifiedBlockTemplate, err := mm.blockTemplateBuilder.ModifyBlockTemplate(coinbaseData, immutableCachedTemplate.Clone())
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 
 		// No point in updating cache since we have no reason to believe this coinbase will be used more
 		// than the previous one, and we want to maintain the original template caching time
-		return modifiedBlockTemplate.Block, modifiedBlockTemplate.IsNearlySynced, nil
+		return modifiedBlockTemplate.Block, cachedTemplateID, modifiedBlockTemplate.IsNearlySynced, nil
 	}
 	defer mm.cacheLock.Unlock()
 	// No relevant cache, build a template
 	blockTemplate, err := mm.blockTemplateBuilder.BuildBlockTemplate(coinbaseData)
 	if err != nil {
-		return nil, false, err
+		return nil, nil, false, err
 	}
 	// Cache the built template
 	mm.setImmutableCachedTemplate(blockTemplate)
-	return blockTemplate.Block, blockTemplate.IsNearlySynced, nil
+	return blockTemplate.Block, mm.cachedTemplateID, blockTemplate.IsNearlySynced, nil
 }
 
 func (mm *miningManager) ClearBlockTemplate() {
 	mm.cacheLock.Lock()
 	mm.cachingTime = time.Time{}
 	mm.cachedBlockTemplate = nil
+	mm.cachedTemplateID = nil
+	mm.recentTemplateIDs.clear()
+	close(mm.blockTemplateClearedChan)
+	mm.blockTemplateClearedChan = make(chan struct{})
 	mm.cacheLock.Unlock()
 }
 
+// BlockTemplateCleared returns a channel that is closed the next time the block
+// template is cleared, which happens whenever a block is added to the DAG
+func (mm *miningManager) BlockTemplateCleared() <-chan struct{} {
+	mm.cacheLock.Lock()
+	defer mm.cacheLock.Unlock()
+	return mm.blockTemplateClearedChan
+}
+
+// IsBlockTemplateStale returns whether the template with the given ID was built
+// before the block template was last cleared, or was never built at all
+func (mm *miningManager) IsBlockTemplateStale(templateID *externalapi.DomainHash) bool {
+	mm.cacheLock.Lock()
+	defer mm.cacheLock.Unlock()
+	return !mm.recentTemplateIDs.contains(templateID)
+}
+
 func (mm *miningManager) getImmutableCachedTemplate() *externalapi.DomainBlockTemplate {
 	if time.Since(mm.cachingTime) > time.Second {
 		// No point in cache optimizations if queries are more than a second apart -- we prefer rechecking the mempool.
@@ -97,12 +140,42 @@ func (mm *miningManager) getImmutableCachedTemplate() *externalapi.DomainBlockTe
 func (mm *miningManager) setImmutableCachedTemplate(blockTemplate *externalapi.DomainBlockTemplate) {
 	mm.cachingTime = time.Now()
 	mm.cachedBlockTemplate = blockTemplate
+	mm.cachedTemplateID = blockTemplateID(blockTemplate)
+	mm.recentTemplateIDs.add(mm.cachedTemplateID)
 }
 
 func (mm *miningManager) GetBlockTemplateBuilder() miningmanagermodel.BlockTemplateBuilder {
 	return mm.blockTemplateBuilder
 }
 
+// SetTransactionSelectionPolicy sets the policy by which transactions are selected
+// from the mempool into block templates. The cached template, if any, is discarded.
+// If the policy is a TransactionPriorityList, transactions are unpinned as they leave the mempool
+func (mm *miningManager) SetTransactionSelectionPolicy(selectionPolicy miningmanagermodel.TransactionSelectionPolicy) {
+	mm.cacheLock.Lock()
+	defer mm.cacheLock.Unlock()
+
+	mm.selectionPolicy = selectionPolicy
+	mm.blockTemplateBuilder.SetTransactionSelectionPolicy(selectionPolicy)
+
+	var transactionRemovedHandler miningmanagermodel.TransactionRemovedHandler
+	if priorityList, ok := selectionPolicy.(miningmanagermodel.TransactionPriorityList); ok {
+		transactionRemovedHandler = priorityList.UnpinTransaction
+	}
+	mm.mempool.SetTransactionRemovedHandler(transactionRemovedHandler)
+	mm.cachingTime = time.Time{}
+	mm.cachedBlockTemplate = nil
+}
+
+// TransactionSelectionPolicy returns the policy by which transactions are selected
+// from the mempool into block templates
+func (mm *miningManager) TransactionSelectionPolicy() miningmanagermodel.TransactionSelectionPolicy {
+	mm.cacheLock.Lock()
+	defer mm.cacheLock.Unlock()
+
+	return mm.selectionPolicy
+}
+
 // HandleNewBlockTransactions handles the transactions for a new block that was just added to the DAG
 func (mm *miningManager) HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error) {
 	return mm.mempool.HandleNewBlockTransactions(txs)
@@ -154,3 +227,17 @@ func (mm *miningManager) RevalidateHighPriorityTransactions() (
 
 	return mm.mempool.RevalidateHighPriorityTransactions()
 }
+
+// GetFeeEstimate estimates the fee rates that are required for a
+// transaction to be mined soon, based on the mempool's contents
+func (mm *miningManager) GetFeeEstimate() *miningmanagermodel.FeeEstimate {
+	return mm.mempool.EstimateFees()
+}
+
+// ValidateTransaction validates the given transaction the same way
+// ValidateAndInsertTransaction does, without inserting it into the mempool
+func (mm *miningManager) ValidateTransaction(transaction *externalapi.DomainTransaction) (
+	*miningmanagermodel.TransactionValidationResult, error) {
+
+	return mm.mempool.ValidateTransaction(transaction)
+}
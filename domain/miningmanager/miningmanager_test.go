@@ -24,6 +24,7 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/txscript"
 	"github.com/shatll-s/nexelliad/domain/consensus/utils/utxo"
 	"github.com/shatll-s/nexelliad/domain/miningmanager"
+	"github.com/shatll-s/nexelliad/domain/miningmanager/blocktemplatebuilder"
 	"github.com/pkg/errors"
 )
 
@@ -42,7 +43,7 @@ func TestValidateAndInsertTransaction(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionsToInsert := make([]*externalapi.DomainTransaction, 10)
 		for i := range transactionsToInsert {
 			transactionsToInsert[i] = createTransactionWithUTXOEntry(t, i, 0)
@@ -80,6 +81,82 @@ func TestValidateAndInsertTransaction(t *testing.T) {
 	})
 }
 
+// TestValidateTransaction verifies that validating a transaction reports its mass, fee and
+// input scripts without inserting it into the mempool.
+func TestValidateTransaction(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestValidateTransaction")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
+
+		transaction := createTransactionWithUTXOEntry(t, 0, 0)
+		result, err := miningManager.ValidateTransaction(transaction)
+		if err != nil {
+			t.Fatalf("ValidateTransaction: %v", err)
+		}
+		if result.RejectReason != "" {
+			t.Fatalf("Unexpected reject reason: %s", result.RejectReason)
+		}
+		if len(result.StandardnessViolations) != 0 {
+			t.Fatalf("Unexpected standardness violations: %v", result.StandardnessViolations)
+		}
+		if result.Mass == 0 {
+			t.Fatalf("Expected the mass to be populated")
+		}
+		expectedFee := transaction.Inputs[0].UTXOEntry.Amount() - transaction.Outputs[0].Value
+		if result.Fee != expectedFee {
+			t.Fatalf("Unexpected fee. Want: %d, got: %d", expectedFee, result.Fee)
+		}
+		if result.FeeRate != float64(result.Fee)/float64(result.Mass) {
+			t.Fatalf("Unexpected fee rate %f", result.FeeRate)
+		}
+		if len(result.InputScriptResults) != 1 || !result.InputScriptResults[0].IsValid {
+			t.Fatalf("Expected the input script to be valid, got: %+v", result.InputScriptResults[0])
+		}
+		if miningManager.TransactionCount(true, true) != 0 {
+			t.Fatalf("ValidateTransaction unexpectedly inserted the transaction into the mempool")
+		}
+
+		// A transaction whose signature script doesn't satisfy its UTXO is rejected,
+		// and the failing input is reported
+		badTransaction := createTransactionWithUTXOEntry(t, 1, 0)
+		badTransaction.Inputs[0].SignatureScript = []byte{}
+		result, err = miningManager.ValidateTransaction(badTransaction)
+		if err != nil {
+			t.Fatalf("ValidateTransaction: %v", err)
+		}
+		if result.RejectReason == "" {
+			t.Fatalf("Expected a transaction with an invalid signature script to be rejected")
+		}
+		if len(result.InputScriptResults) != 1 || result.InputScriptResults[0].IsValid {
+			t.Fatalf("Expected the input script to be invalid")
+		}
+
+		// Once the transaction is in the mempool, validating it again reports it as a duplicate
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		result, err = miningManager.ValidateTransaction(transaction)
+		if err != nil {
+			t.Fatalf("ValidateTransaction: %v", err)
+		}
+		if !strings.Contains(result.RejectReason, "already in the mempool") {
+			t.Fatalf("Unexpected reject reason: %s", result.RejectReason)
+		}
+	})
+}
+
 func TestImmatureSpend(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
 		factory := consensus.NewFactory()
@@ -93,7 +170,7 @@ func TestImmatureSpend(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		tx := createTransactionWithUTXOEntry(t, 0, consensusConfig.GenesisBlock.Header.DAAScore())
 		_, err = miningManager.ValidateAndInsertTransaction(tx, false, false)
 		txRuleError := &mempool.TxRuleError{}
@@ -123,7 +200,7 @@ func TestInsertDoubleTransactionsToMempool(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transaction := createTransactionWithUTXOEntry(t, 0, 0)
 		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
 		if err != nil {
@@ -152,7 +229,7 @@ func TestDoubleSpendInMempool(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
 		if err != nil {
 			t.Fatalf("Error creating transaction: %+v", err)
@@ -188,7 +265,7 @@ func TestHandleNewBlockTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionsToInsert := make([]*externalapi.DomainTransaction, 10)
 		for i := range transactionsToInsert {
 			transaction := createTransactionWithUTXOEntry(t, i, 0)
@@ -258,7 +335,7 @@ func TestDoubleSpendWithBlock(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionInTheMempool := createTransactionWithUTXOEntry(t, 0, 0)
 		_, err = miningManager.ValidateAndInsertTransaction(transactionInTheMempool, false, true)
 		if err != nil {
@@ -279,6 +356,154 @@ func TestDoubleSpendWithBlock(t *testing.T) {
 	})
 }
 
+// TestMempoolEvents verifies that the mempool raises an event for every transaction
+// that is added or removed, along with the reason for the removal.
+func TestMempoolEvents(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMempoolEvents")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolEventsChan := make(chan model.MempoolEvent, 10)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params), mempoolEventsChan)
+
+		acceptedTransaction := createTransactionWithUTXOEntry(t, 0, 0)
+		doubleSpentTransaction := createTransactionWithUTXOEntry(t, 1, 0)
+		for _, transaction := range []*externalapi.DomainTransaction{acceptedTransaction, doubleSpentTransaction} {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+			event := (<-mempoolEventsChan).(*model.TransactionAddedToMempool)
+			if !consensushashing.TransactionID(event.Transaction).Equal(consensushashing.TransactionID(transaction)) {
+				t.Fatalf("Expected an added event for transaction %s, but got one for %s",
+					consensushashing.TransactionID(transaction), consensushashing.TransactionID(event.Transaction))
+			}
+			if event.IsOrphan {
+				t.Fatalf("Transaction %s was unexpectedly added as an orphan", consensushashing.TransactionID(transaction))
+			}
+		}
+
+		// Change the output as well, so that the double spend doesn't end up identical to the transaction it spends against
+		doubleSpendTransactionInTheBlock := createTransactionWithUTXOEntry(t, 1, 0)
+		doubleSpendTransactionInTheBlock.Outputs[0].Value++
+		blockTransactions := []*externalapi.DomainTransaction{nil, acceptedTransaction, doubleSpendTransactionInTheBlock}
+		_, err = miningManager.HandleNewBlockTransactions(blockTransactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+
+		expectedRemovals := []struct {
+			transaction *externalapi.DomainTransaction
+			reason      model.TransactionRemovalReason
+		}{
+			{transaction: acceptedTransaction, reason: model.RemovalReasonAcceptedInBlock},
+			{transaction: doubleSpentTransaction, reason: model.RemovalReasonDoubleSpent},
+		}
+		for _, expectedRemoval := range expectedRemovals {
+			event := (<-mempoolEventsChan).(*model.TransactionRemovedFromMempool)
+			expectedTransactionID := consensushashing.TransactionID(expectedRemoval.transaction)
+			if !consensushashing.TransactionID(event.Transaction).Equal(expectedTransactionID) {
+				t.Fatalf("Expected a removed event for transaction %s, but got one for %s",
+					expectedTransactionID, consensushashing.TransactionID(event.Transaction))
+			}
+			if event.Reason != expectedRemoval.reason {
+				t.Fatalf("Expected transaction %s to be removed because %s, but got %s",
+					expectedTransactionID, expectedRemoval.reason, event.Reason)
+			}
+		}
+		if len(mempoolEventsChan) != 0 {
+			t.Fatalf("Got %d unexpected mempool events", len(mempoolEventsChan))
+		}
+	})
+}
+
+// unpinRecordingPolicy is a priority list selection policy that records the transactions it unpins
+type unpinRecordingPolicy struct {
+	blocktemplatebuilder.PriorityListSelectionPolicy
+	unpinnedTransactionIDs []*externalapi.DomainTransactionID
+}
+
+func (urp *unpinRecordingPolicy) UnpinTransaction(transactionID *externalapi.DomainTransactionID) {
+	urp.unpinnedTransactionIDs = append(urp.unpinnedTransactionIDs, transactionID)
+	urp.PriorityListSelectionPolicy.UnpinTransaction(transactionID)
+}
+
+// TestUnpinTransactionsLeavingMempool verifies that transactions are unpinned from a
+// priority list selection policy once they leave the mempool
+func TestUnpinTransactionsLeavingMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestUnpinTransactionsLeavingMempool")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params), nil)
+		selectionPolicy := &unpinRecordingPolicy{PriorityListSelectionPolicy: blocktemplatebuilder.NewPriorityListSelectionPolicy()}
+		miningManager.SetTransactionSelectionPolicy(selectionPolicy)
+
+		acceptedTransaction := createTransactionWithUTXOEntry(t, 0, 0)
+		doubleSpentTransaction := createTransactionWithUTXOEntry(t, 1, 0)
+		remainingTransaction := createTransactionWithUTXOEntry(t, 2, 0)
+		for _, transaction := range []*externalapi.DomainTransaction{acceptedTransaction, doubleSpentTransaction, remainingTransaction} {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+			selectionPolicy.PinTransaction(consensushashing.TransactionID(transaction), 1000)
+		}
+
+		doubleSpendTransactionInTheBlock := createTransactionWithUTXOEntry(t, 1, 0)
+		doubleSpendTransactionInTheBlock.Outputs[0].Value++
+		blockTransactions := []*externalapi.DomainTransaction{nil, acceptedTransaction, doubleSpendTransactionInTheBlock}
+		_, err = miningManager.HandleNewBlockTransactions(blockTransactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+
+		expectedUnpinnedTransactionIDs := []*externalapi.DomainTransactionID{
+			consensushashing.TransactionID(acceptedTransaction),
+			consensushashing.TransactionID(doubleSpentTransaction),
+		}
+		if len(selectionPolicy.unpinnedTransactionIDs) != len(expectedUnpinnedTransactionIDs) {
+			t.Fatalf("Expected %d transactions to be unpinned, but got %d",
+				len(expectedUnpinnedTransactionIDs), len(selectionPolicy.unpinnedTransactionIDs))
+		}
+		for i, transactionID := range selectionPolicy.unpinnedTransactionIDs {
+			if !transactionID.Equal(expectedUnpinnedTransactionIDs[i]) {
+				t.Fatalf("Expected transaction %s to be unpinned, but got %s", expectedUnpinnedTransactionIDs[i], transactionID)
+			}
+		}
+
+		// Once another policy is set, the previous one is no longer notified
+		miningManager.SetTransactionSelectionPolicy(blocktemplatebuilder.NewGreedySelectionPolicy())
+		_, err = miningManager.HandleNewBlockTransactions([]*externalapi.DomainTransaction{nil, remainingTransaction})
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+		if len(selectionPolicy.unpinnedTransactionIDs) != len(expectedUnpinnedTransactionIDs) {
+			t.Fatalf("A selection policy that was replaced unexpectedly unpinned a transaction")
+		}
+	})
+}
+
 // TestOrphanTransactions verifies that a transaction could be a part of a new block template, only if it's not an orphan.
 func TestOrphanTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -294,7 +519,7 @@ func TestOrphanTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		// Before each parent transaction, We will add two blocks by consensus in order to fund the parent transactions.
 		parentTransactions, childTransactions, err := createArraysOfParentAndChildrenTransactions(tc)
 		if err != nil {
@@ -313,7 +538,7 @@ func TestOrphanTransactions(t *testing.T) {
 			}
 		}
 
-		block, _, err := miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
+		block, _, _, err := miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
 			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
 			ExtraData:       nil})
 		if err != nil {
@@ -359,7 +584,7 @@ func TestOrphanTransactions(t *testing.T) {
 					"oprhan anymore.", consensushashing.TransactionID(transaction))
 			}
 		}
-		block, _, err = miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
+		block, _, _, err = miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
 			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
 			ExtraData:       nil})
 		if err != nil {
@@ -397,7 +622,7 @@ func TestHighPriorityTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
 
 		// Create 3 pairs of transaction parent-and-child pairs: 1 low priority and 2 high priority
 		lowPriorityParentTransaction, lowPriorityChildTransaction, err := createParentAndChildrenTransactions(tc)
@@ -490,7 +715,7 @@ func TestRevalidateHighPriorityTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
 
 		// Create two valid transactions that double-spend each other (childTransaction1, childTransaction2)
 		parentTransaction, childTransaction1, err := createParentAndChildrenTransactions(tc)
@@ -593,7 +818,7 @@ func TestModifyBlockTemplate(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 
 		// Create some complex transactions. Logic taken from TestOrphanTransactions
 
@@ -618,7 +843,7 @@ func TestModifyBlockTemplate(t *testing.T) {
 		emptyCoinbaseData := &externalapi.DomainCoinbaseData{
 			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
 			ExtraData:       nil}
-		block, _, err := miningManager.GetBlockTemplate(emptyCoinbaseData)
+		block, _, _, err := miningManager.GetBlockTemplate(emptyCoinbaseData)
 		if err != nil {
 			t.Fatalf("Failed get a block template: %v", err)
 		}
@@ -668,7 +893,7 @@ func TestModifyBlockTemplate(t *testing.T) {
 					"oprhan anymore.", consensushashing.TransactionID(transaction))
 			}
 		}
-		block, _, err = miningManager.GetBlockTemplate(emptyCoinbaseData)
+		block, _, _, err = miningManager.GetBlockTemplate(emptyCoinbaseData)
 		if err != nil {
 			t.Fatalf("GetBlockTemplate: %v", err)
 		}
@@ -742,6 +967,90 @@ func TestModifyBlockTemplate(t *testing.T) {
 	})
 }
 
+func TestBlockTemplateID(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestBlockTemplateID")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
+
+		emptyCoinbaseData := &externalapi.DomainCoinbaseData{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
+			ExtraData:       nil}
+		_, templateID, _, err := miningManager.GetBlockTemplate(emptyCoinbaseData)
+		if err != nil {
+			t.Fatalf("GetBlockTemplate: %v", err)
+		}
+		if miningManager.IsBlockTemplateStale(templateID) {
+			t.Fatalf("Expected the template to not be stale right after it was built")
+		}
+
+		// The template ID should not depend on the coinbase data
+		coinbaseUsual, err := generateNewCoinbase(consensusConfig.Prefix, opUsual)
+		if err != nil {
+			t.Fatalf("Generate coinbase: %v.", err)
+		}
+		_, otherCoinbaseTemplateID, _, err := miningManager.GetBlockTemplate(coinbaseUsual)
+		if err != nil {
+			t.Fatalf("GetBlockTemplate: %v", err)
+		}
+		if !otherCoinbaseTemplateID.Equal(templateID) {
+			t.Fatalf("Expected templates with different coinbase data to have the same ID, but got %s and %s",
+				templateID, otherCoinbaseTemplateID)
+		}
+
+		// Rebuilding the template over the same virtual should keep its ID
+		miningManager.ClearBlockTemplate()
+		_, rebuiltTemplateID, _, err := miningManager.GetBlockTemplate(emptyCoinbaseData)
+		if err != nil {
+			t.Fatalf("GetBlockTemplate: %v", err)
+		}
+		if !rebuiltTemplateID.Equal(templateID) {
+			t.Fatalf("Expected a rebuilt template to have the same ID, but got %s and %s",
+				templateID, rebuiltTemplateID)
+		}
+
+		blockTemplateCleared := miningManager.BlockTemplateCleared()
+		tips, err := tc.Tips()
+		if err != nil {
+			t.Fatalf("Tips: %v.", err)
+		}
+		_, _, err = tc.AddBlock(tips, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %v", err)
+		}
+		miningManager.ClearBlockTemplate()
+
+		select {
+		case <-blockTemplateCleared:
+		default:
+			t.Fatalf("Expected the block template cleared channel to be closed")
+		}
+		if !miningManager.IsBlockTemplateStale(templateID) {
+			t.Fatalf("Expected the template to be stale after a block was added")
+		}
+
+		_, newTemplateID, _, err := miningManager.GetBlockTemplate(emptyCoinbaseData)
+		if err != nil {
+			t.Fatalf("GetBlockTemplate: %v", err)
+		}
+		if newTemplateID.Equal(templateID) {
+			t.Fatalf("Expected the template ID to change after a block was added")
+		}
+		if miningManager.IsBlockTemplateStale(newTemplateID) {
+			t.Fatalf("Expected the new template to not be stale")
+		}
+	})
+}
+
 func sweepCompareModifiedTemplateToBuilt(
 	t *testing.T, consensusConfig *consensus.Config, builder model.BlockTemplateBuilder) {
 	for i := 0; i < 4; i++ {
@@ -0,0 +1,16 @@
+package model
+
+// FeeEstimateBucket is a fee rate, in sompi per gram of mass, together with the
+// number of blocks a transaction that pays it is expected to wait until it's mined
+type FeeEstimateBucket struct {
+	FeeRate         float64
+	EstimatedBlocks uint64
+}
+
+// FeeEstimate holds the fee rates that are expected to get a transaction
+// mined within a short, medium and long time
+type FeeEstimate struct {
+	Priority FeeEstimateBucket
+	Normal   FeeEstimateBucket
+	Low      FeeEstimateBucket
+}
@@ -9,4 +9,5 @@ type BlockTemplateBuilder interface {
 	BuildBlockTemplate(coinbaseData *consensusexternalapi.DomainCoinbaseData) (*consensusexternalapi.DomainBlockTemplate, error)
 	ModifyBlockTemplate(newCoinbaseData *consensusexternalapi.DomainCoinbaseData,
 		blockTemplateToModify *consensusexternalapi.DomainBlockTemplate) (*consensusexternalapi.DomainBlockTemplate, error)
+	SetTransactionSelectionPolicy(selectionPolicy TransactionSelectionPolicy)
 }
@@ -11,6 +11,7 @@ type Mempool interface {
 	BlockCandidateTransactions() []*externalapi.DomainTransaction
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateTransaction(transaction *externalapi.DomainTransaction) (*TransactionValidationResult, error)
 	RemoveTransactions(txs []*externalapi.DomainTransaction, removeRedeemers bool) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -39,4 +40,6 @@ type Mempool interface {
 		includeOrphanPool bool) int
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 	IsTransactionOutputDust(output *externalapi.DomainTransactionOutput) bool
+	EstimateFees() *FeeEstimate
+	SetTransactionRemovedHandler(handler TransactionRemovedHandler)
 }
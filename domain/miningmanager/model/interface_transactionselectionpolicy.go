@@ -0,0 +1,25 @@
+package model
+
+import (
+	consensusexternalapi "github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// TransactionSelectionPolicy selects which of the candidate transactions from the
+// mempool go into a block template
+type TransactionSelectionPolicy interface {
+	// SelectTransactions returns the transactions to include in a block template
+	// out of candidateTxs, whose total mass must not exceed blockMaxMass
+	SelectTransactions(candidateTxs []*consensusexternalapi.DomainTransaction,
+		blockMaxMass uint64) []*consensusexternalapi.DomainTransaction
+}
+
+// TransactionPriorityList is implemented by selection policies that let an operator
+// pin transactions, either by their ID or by an address they spend from or pay to.
+// Pinned transactions are selected before all others, and their fees are adjusted
+// by the fee delta they were pinned with when ordering them
+type TransactionPriorityList interface {
+	PinTransaction(transactionID *consensusexternalapi.DomainTransactionID, feeDelta int64)
+	UnpinTransaction(transactionID *consensusexternalapi.DomainTransactionID)
+	PinScriptPublicKey(scriptPublicKey *consensusexternalapi.ScriptPublicKey, feeDelta int64)
+	UnpinScriptPublicKey(scriptPublicKey *consensusexternalapi.ScriptPublicKey)
+}
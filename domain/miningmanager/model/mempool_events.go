@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// MempoolEvent is an interface type that is implemented by all events raised by the mempool
+type MempoolEvent interface {
+	isMempoolEvent()
+}
+
+// TransactionAddedToMempool is an event raised by the mempool when a transaction
+// enters either the transaction pool or the orphan pool
+type TransactionAddedToMempool struct {
+	Transaction *externalapi.DomainTransaction
+	IsOrphan    bool
+}
+
+func (*TransactionAddedToMempool) isMempoolEvent() {}
+
+// TransactionRemovedFromMempool is an event raised by the mempool when a transaction
+// leaves either the transaction pool or the orphan pool
+type TransactionRemovedFromMempool struct {
+	Transaction *externalapi.DomainTransaction
+	IsOrphan    bool
+	Reason      TransactionRemovalReason
+}
+
+func (*TransactionRemovedFromMempool) isMempoolEvent() {}
+
+// TransactionRemovedHandler is called by the mempool with the ID of every transaction that leaves it.
+// Unlike mempool events, it's called synchronously, so it must not call back into the mempool
+type TransactionRemovedHandler func(transactionID *externalapi.DomainTransactionID)
+
+// TransactionRemovalReason is the reason a transaction was removed from the mempool
+type TransactionRemovalReason uint8
+
+const (
+	// RemovalReasonAcceptedInBlock means the transaction was included in a block
+	RemovalReasonAcceptedInBlock TransactionRemovalReason = iota
+
+	// RemovalReasonExpired means the transaction stayed in the mempool for too long
+	RemovalReasonExpired
+
+	// RemovalReasonEvicted means the transaction was evicted to keep the mempool within its size limits
+	RemovalReasonEvicted
+
+	// RemovalReasonDoubleSpent means a block included another transaction that spends the same outputs
+	RemovalReasonDoubleSpent
+
+	// RemovalReasonOrphanPromoted means an orphan's missing parents arrived, and
+	// it moved from the orphan pool to the transaction pool
+	RemovalReasonOrphanPromoted
+
+	// RemovalReasonInvalid means the transaction is no longer valid, e.g. because
+	// it failed revalidation or could not be put in a block template
+	RemovalReasonInvalid
+)
+
+var transactionRemovalReasonStrings = map[TransactionRemovalReason]string{
+	RemovalReasonAcceptedInBlock: "AcceptedInBlock",
+	RemovalReasonExpired:         "Expired",
+	RemovalReasonEvicted:         "Evicted",
+	RemovalReasonDoubleSpent:     "DoubleSpent",
+	RemovalReasonOrphanPromoted:  "OrphanPromoted",
+	RemovalReasonInvalid:         "Invalid",
+}
+
+func (r TransactionRemovalReason) String() string {
+	if reasonString, ok := transactionRemovalReasonStrings[r]; ok {
+		return reasonString
+	}
+	return "Unknown"
+}
@@ -0,0 +1,23 @@
+package model
+
+// InputScriptResult is the outcome of verifying the signature script of a
+// single transaction input against the script public key it spends
+type InputScriptResult struct {
+	IsValid bool
+	Error   string
+}
+
+// TransactionValidationResult holds the outcome of validating a transaction
+// the same way the mempool does, without inserting it
+type TransactionValidationResult struct {
+	Mass    uint64
+	Fee     uint64
+	FeeRate float64
+
+	// RejectReason is the reason the mempool would have rejected the
+	// transaction, or an empty string if it would have been accepted
+	RejectReason string
+
+	StandardnessViolations []string
+	InputScriptResults     []*InputScriptResult
+}
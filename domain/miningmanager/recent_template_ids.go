@@ -0,0 +1,49 @@
+package miningmanager
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// maxRecentTemplateIDs bounds recentTemplateIDs for when no block is added
+// to the DAG for a long while, and templates keep being rebuilt
+const maxRecentTemplateIDs = 1000
+
+// recentTemplateIDs is a set of up to maxRecentTemplateIDs template IDs. Once
+// it's full, adding an ID evicts the oldest one, so that the templates that were
+// handed out last are never the ones that become stale
+type recentTemplateIDs struct {
+	ids map[externalapi.DomainHash]struct{}
+
+	// order is a ring buffer of the IDs in the order they were added,
+	// and next is the position the next ID is written to
+	order [maxRecentTemplateIDs]externalapi.DomainHash
+	next  int
+}
+
+func newRecentTemplateIDs() *recentTemplateIDs {
+	return &recentTemplateIDs{ids: make(map[externalapi.DomainHash]struct{})}
+}
+
+func (rti *recentTemplateIDs) add(templateID *externalapi.DomainHash) {
+	if rti.contains(templateID) {
+		return
+	}
+	// The ring buffer is filled in order from its start, so once the set is
+	// full the ID at next is always the oldest one
+	if len(rti.ids) == maxRecentTemplateIDs {
+		delete(rti.ids, rti.order[rti.next])
+	}
+	rti.ids[*templateID] = struct{}{}
+	rti.order[rti.next] = *templateID
+	rti.next = (rti.next + 1) % maxRecentTemplateIDs
+}
+
+func (rti *recentTemplateIDs) clear() {
+	rti.ids = make(map[externalapi.DomainHash]struct{})
+	rti.next = 0
+}
+
+func (rti *recentTemplateIDs) contains(templateID *externalapi.DomainHash) bool {
+	_, ok := rti.ids[*templateID]
+	return ok
+}
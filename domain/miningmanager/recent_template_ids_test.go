@@ -0,0 +1,48 @@
+package miningmanager
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+func TestRecentTemplateIDs(t *testing.T) {
+	templateID := func(i int) *externalapi.DomainHash {
+		var hashBytes [externalapi.DomainHashSize]byte
+		binary.LittleEndian.PutUint64(hashBytes[:], uint64(i))
+		return externalapi.NewDomainHashFromByteArray(&hashBytes)
+	}
+
+	recent := newRecentTemplateIDs()
+	for i := 0; i < maxRecentTemplateIDs; i++ {
+		recent.add(templateID(i))
+	}
+	// Adding an ID that is already in the set must not evict anything
+	recent.add(templateID(0))
+	for i := 0; i < maxRecentTemplateIDs; i++ {
+		if !recent.contains(templateID(i)) {
+			t.Fatalf("Expected template ID %d to be recent", i)
+		}
+	}
+
+	// Once the set is full, only the oldest IDs are evicted
+	const added = 10
+	for i := maxRecentTemplateIDs; i < maxRecentTemplateIDs+added; i++ {
+		recent.add(templateID(i))
+	}
+	for i := 0; i < maxRecentTemplateIDs+added; i++ {
+		if recent.contains(templateID(i)) != (i >= added) {
+			t.Fatalf("Unexpected recency of template ID %d: %t", i, recent.contains(templateID(i)))
+		}
+	}
+
+	recent.clear()
+	if recent.contains(templateID(maxRecentTemplateIDs)) {
+		t.Fatalf("Expected no template ID to be recent after clearing")
+	}
+	recent.add(templateID(0))
+	if !recent.contains(templateID(0)) {
+		t.Fatalf("Expected template ID 0 to be recent")
+	}
+}
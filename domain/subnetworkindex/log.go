@@ -0,0 +1,7 @@
+package subnetworkindex
+
+import (
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("SNIN")
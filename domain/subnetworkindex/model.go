@@ -0,0 +1,54 @@
+package subnetworkindex
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// SubnetworkData is the data the subnetwork index holds for every non-native
+// subnetwork that was either registered or used by an accepted transaction
+type SubnetworkData struct {
+	// FirstSeenBlockHash is the block that included the first accepted
+	// transaction that either registered or used the subnetwork
+	FirstSeenBlockHash *externalapi.DomainHash
+
+	// IsRegistered is true if a subnetwork registry transaction for
+	// this subnetwork was accepted
+	IsRegistered bool
+
+	// GasLimit is the gas limit set by the registry transaction, or 0
+	// if the subnetwork is not registered
+	GasLimit uint64
+
+	// GasUsed is the total gas of all the accepted transactions in the subnetwork
+	GasUsed uint64
+
+	// TransactionCount is the number of accepted transactions in the subnetwork
+	TransactionCount uint64
+}
+
+// SubnetworkDataMap is a map between subnetwork IDs and their data
+type SubnetworkDataMap map[externalapi.DomainSubnetworkID]*SubnetworkData
+
+// SubnetworkIDs is a set of subnetwork IDs
+type SubnetworkIDs map[externalapi.DomainSubnetworkID]interface{}
+
+// chainBlockJournalEntry records what a single chain block changed in the subnetwork index,
+// so that the chain block can be reverted without its acceptance data, which might not be
+// available anymore once the index is reset. It is kept until the chain block is at or below
+// the pruning point, at which point its changes are final
+type chainBlockJournalEntry struct {
+	chainBlockHash *externalapi.DomainHash
+	blueScore      uint64
+
+	// previousSubnetworkData holds the data every subnetwork the chain block changed had
+	// before it, or nil for subnetworks that were not in the index before it
+	previousSubnetworkData SubnetworkDataMap
+}
+
+func newChainBlockJournalEntry(chainBlockHash *externalapi.DomainHash, blueScore uint64) *chainBlockJournalEntry {
+	return &chainBlockJournalEntry{
+		chainBlockHash:         chainBlockHash,
+		blueScore:              blueScore,
+		previousSubnetworkData: make(SubnetworkDataMap),
+	}
+}
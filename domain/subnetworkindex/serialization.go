@@ -0,0 +1,120 @@
+package subnetworkindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const serializedSubnetworkDataSize = externalapi.DomainHashSize + 1 + 3*8
+
+func serializeSubnetworkData(subnetworkData *SubnetworkData) []byte {
+	serializedSubnetworkData := make([]byte, serializedSubnetworkDataSize)
+	copy(serializedSubnetworkData[:externalapi.DomainHashSize], subnetworkData.FirstSeenBlockHash.ByteSlice())
+	offset := externalapi.DomainHashSize
+	if subnetworkData.IsRegistered {
+		serializedSubnetworkData[offset] = 1
+	}
+	offset++
+	binary.LittleEndian.PutUint64(serializedSubnetworkData[offset:], subnetworkData.GasLimit)
+	offset += 8
+	binary.LittleEndian.PutUint64(serializedSubnetworkData[offset:], subnetworkData.GasUsed)
+	offset += 8
+	binary.LittleEndian.PutUint64(serializedSubnetworkData[offset:], subnetworkData.TransactionCount)
+	return serializedSubnetworkData
+}
+
+func deserializeSubnetworkData(serializedSubnetworkData []byte) (*SubnetworkData, error) {
+	if len(serializedSubnetworkData) != serializedSubnetworkDataSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"subnetwork data", len(serializedSubnetworkData))
+	}
+
+	firstSeenBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedSubnetworkData[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	offset := externalapi.DomainHashSize
+	isRegistered := serializedSubnetworkData[offset] != 0
+	offset++
+	gasLimit := binary.LittleEndian.Uint64(serializedSubnetworkData[offset:])
+	offset += 8
+	gasUsed := binary.LittleEndian.Uint64(serializedSubnetworkData[offset:])
+	offset += 8
+	transactionCount := binary.LittleEndian.Uint64(serializedSubnetworkData[offset:])
+
+	return &SubnetworkData{
+		FirstSeenBlockHash: firstSeenBlockHash,
+		IsRegistered:       isRegistered,
+		GasLimit:           gasLimit,
+		GasUsed:            gasUsed,
+		TransactionCount:   transactionCount,
+	}, nil
+}
+
+const serializedJournalEntryHeaderSize = externalapi.DomainHashSize + 8
+
+func serializeChainBlockJournalEntry(journalEntry *chainBlockJournalEntry) []byte {
+	serializedJournalEntry := make([]byte, serializedJournalEntryHeaderSize,
+		serializedJournalEntryHeaderSize+len(journalEntry.previousSubnetworkData)*
+			(externalapi.DomainSubnetworkIDSize+1+serializedSubnetworkDataSize))
+	copy(serializedJournalEntry[:externalapi.DomainHashSize], journalEntry.chainBlockHash.ByteSlice())
+	binary.LittleEndian.PutUint64(serializedJournalEntry[externalapi.DomainHashSize:], journalEntry.blueScore)
+
+	for subnetworkID, previousSubnetworkData := range journalEntry.previousSubnetworkData {
+		serializedJournalEntry = append(serializedJournalEntry, subnetworkID[:]...)
+		if previousSubnetworkData == nil {
+			serializedJournalEntry = append(serializedJournalEntry, 0)
+			continue
+		}
+		serializedJournalEntry = append(serializedJournalEntry, 1)
+		serializedJournalEntry = append(serializedJournalEntry, serializeSubnetworkData(previousSubnetworkData)...)
+	}
+	return serializedJournalEntry
+}
+
+func deserializeChainBlockJournalEntry(serializedJournalEntry []byte) (*chainBlockJournalEntry, error) {
+	if len(serializedJournalEntry) < serializedJournalEntryHeaderSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"a journal entry", len(serializedJournalEntry))
+	}
+
+	chainBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedJournalEntry[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	blueScore := binary.LittleEndian.Uint64(serializedJournalEntry[externalapi.DomainHashSize:])
+	journalEntry := newChainBlockJournalEntry(chainBlockHash, blueScore)
+
+	remaining := serializedJournalEntry[serializedJournalEntryHeaderSize:]
+	for len(remaining) > 0 {
+		if len(remaining) < externalapi.DomainSubnetworkIDSize+1 {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+				"a journal entry", len(serializedJournalEntry))
+		}
+		var subnetworkID externalapi.DomainSubnetworkID
+		copy(subnetworkID[:], remaining[:externalapi.DomainSubnetworkIDSize])
+		hasPreviousSubnetworkData := remaining[externalapi.DomainSubnetworkIDSize] != 0
+		remaining = remaining[externalapi.DomainSubnetworkIDSize+1:]
+
+		if !hasPreviousSubnetworkData {
+			journalEntry.previousSubnetworkData[subnetworkID] = nil
+			continue
+		}
+		if len(remaining) < serializedSubnetworkDataSize {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+				"a journal entry", len(serializedJournalEntry))
+		}
+		previousSubnetworkData, err := deserializeSubnetworkData(remaining[:serializedSubnetworkDataSize])
+		if err != nil {
+			return nil, err
+		}
+		journalEntry.previousSubnetworkData[subnetworkID] = previousSubnetworkData
+		remaining = remaining[serializedSubnetworkDataSize:]
+	}
+	return journalEntry, nil
+}
@@ -0,0 +1,74 @@
+package subnetworkindex
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeSubnetworkData(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var firstSeenBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(firstSeenBlockHashBytes[:])
+		subnetworkData := &SubnetworkData{
+			FirstSeenBlockHash: externalapi.NewDomainHashFromByteArray(&firstSeenBlockHashBytes),
+			IsRegistered:       r.Intn(2) == 1,
+			GasLimit:           r.Uint64(),
+			GasUsed:            r.Uint64(),
+			TransactionCount:   r.Uint64(),
+		}
+		result, err := deserializeSubnetworkData(serializeSubnetworkData(subnetworkData))
+		if err != nil {
+			t.Fatalf("Failed deserializing subnetwork data: %v", err)
+		}
+		if !reflect.DeepEqual(result, subnetworkData) {
+			t.Fatalf("Expected \n %+v \n==\n %+v\n", subnetworkData, result)
+		}
+	}
+}
+
+func Test_deserializeSubnetworkDataFailure(t *testing.T) {
+	subnetworkData := &SubnetworkData{
+		FirstSeenBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		GasUsed:            2,
+		TransactionCount:   1,
+	}
+	serialized := serializeSubnetworkData(subnetworkData)
+	_, err := deserializeSubnetworkData(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
+
+func Test_serializeChainBlockJournalEntry(t *testing.T) {
+	journalEntry := newChainBlockJournalEntry(
+		externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}), 12345)
+	journalEntry.previousSubnetworkData[externalapi.DomainSubnetworkID{3}] = nil
+	journalEntry.previousSubnetworkData[externalapi.DomainSubnetworkID{4}] = &SubnetworkData{
+		FirstSeenBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{5}),
+		IsRegistered:       true,
+		GasLimit:           6,
+		GasUsed:            7,
+		TransactionCount:   8,
+	}
+
+	serialized := serializeChainBlockJournalEntry(journalEntry)
+	result, err := deserializeChainBlockJournalEntry(serialized)
+	if err != nil {
+		t.Fatalf("Failed deserializing journal entry: %v", err)
+	}
+	if !reflect.DeepEqual(result, journalEntry) {
+		t.Fatalf("Expected \n %+v \n==\n %+v\n", journalEntry, result)
+	}
+
+	_, err = deserializeChainBlockJournalEntry(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
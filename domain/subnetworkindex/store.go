@@ -0,0 +1,274 @@
+package subnetworkindex
+
+import (
+	"sort"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var subnetworkIndexBucket = database.MakeBucket([]byte("subnetwork-index"))
+var journalBucket = database.MakeBucket([]byte("subnetwork-index-journal"))
+var virtualSelectedParentKey = database.MakeBucket([]byte("")).Key([]byte("subnetwork-index-virtual-selected-parent"))
+
+type subnetworkIndexStore struct {
+	database database.Database
+	toUpdate SubnetworkDataMap
+	toRemove SubnetworkIDs
+
+	journalToAdd    []*chainBlockJournalEntry
+	journalToRemove []*externalapi.DomainHash
+
+	virtualSelectedParent *externalapi.DomainHash
+}
+
+func newSubnetworkIndexStore(database database.Database) *subnetworkIndexStore {
+	return &subnetworkIndexStore{
+		database: database,
+		toUpdate: make(SubnetworkDataMap),
+		toRemove: make(SubnetworkIDs),
+	}
+}
+
+// getStaged returns the data of the given subnetwork, taking whatever is
+// currently staged into account. The returned data may be modified and
+// then staged again with update
+func (sis *subnetworkIndexStore) getStaged(subnetworkID *externalapi.DomainSubnetworkID) (*SubnetworkData, bool, error) {
+	if subnetworkData, ok := sis.toUpdate[*subnetworkID]; ok {
+		return subnetworkData, true, nil
+	}
+	if _, ok := sis.toRemove[*subnetworkID]; ok {
+		return nil, false, nil
+	}
+	return sis.getFromDatabase(subnetworkID)
+}
+
+func (sis *subnetworkIndexStore) update(subnetworkID *externalapi.DomainSubnetworkID, subnetworkData *SubnetworkData) {
+	log.Tracef("Updating subnetwork %s: %+v", subnetworkID, subnetworkData)
+
+	delete(sis.toRemove, *subnetworkID)
+	sis.toUpdate[*subnetworkID] = subnetworkData
+}
+
+func (sis *subnetworkIndexStore) remove(subnetworkID *externalapi.DomainSubnetworkID) {
+	log.Tracef("Removing subnetwork %s", subnetworkID)
+
+	delete(sis.toUpdate, *subnetworkID)
+	sis.toRemove[*subnetworkID] = struct{}{}
+}
+
+func (sis *subnetworkIndexStore) addJournalEntry(journalEntry *chainBlockJournalEntry) {
+	log.Tracef("Adding the journal entry of chain block %s", journalEntry.chainBlockHash)
+
+	sis.journalToAdd = append(sis.journalToAdd, journalEntry)
+}
+
+func (sis *subnetworkIndexStore) removeJournalEntry(chainBlockHash *externalapi.DomainHash) {
+	log.Tracef("Removing the journal entry of chain block %s", chainBlockHash)
+
+	sis.journalToRemove = append(sis.journalToRemove, chainBlockHash)
+}
+
+func (sis *subnetworkIndexStore) updateVirtualSelectedParent(virtualSelectedParent *externalapi.DomainHash) {
+	sis.virtualSelectedParent = virtualSelectedParent
+}
+
+func (sis *subnetworkIndexStore) discard() {
+	sis.toUpdate = make(SubnetworkDataMap)
+	sis.toRemove = make(SubnetworkIDs)
+	sis.journalToAdd = nil
+	sis.journalToRemove = nil
+	sis.virtualSelectedParent = nil
+}
+
+func (sis *subnetworkIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "subnetworkIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := sis.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for subnetworkIDToRemove := range sis.toRemove {
+		err := dbTransaction.Delete(sis.convertSubnetworkIDToKey(&subnetworkIDToRemove))
+		if err != nil {
+			return err
+		}
+	}
+
+	for subnetworkIDToUpdate, subnetworkDataToUpdate := range sis.toUpdate {
+		err := dbTransaction.Put(sis.convertSubnetworkIDToKey(&subnetworkIDToUpdate),
+			serializeSubnetworkData(subnetworkDataToUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, chainBlockHash := range sis.journalToRemove {
+		err := dbTransaction.Delete(journalBucket.Key(chainBlockHash.ByteSlice()))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, journalEntry := range sis.journalToAdd {
+		err := dbTransaction.Put(journalBucket.Key(journalEntry.chainBlockHash.ByteSlice()),
+			serializeChainBlockJournalEntry(journalEntry))
+		if err != nil {
+			return err
+		}
+	}
+
+	if sis.virtualSelectedParent != nil {
+		err = dbTransaction.Put(virtualSelectedParentKey, sis.virtualSelectedParent.ByteSlice())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	sis.discard()
+	return nil
+}
+
+func (sis *subnetworkIndexStore) convertSubnetworkIDToKey(subnetworkID *externalapi.DomainSubnetworkID) *database.Key {
+	return subnetworkIndexBucket.Key(subnetworkID[:])
+}
+
+func (sis *subnetworkIndexStore) isAnythingStaged() bool {
+	return len(sis.toUpdate) > 0 || len(sis.toRemove) > 0 ||
+		len(sis.journalToAdd) > 0 || len(sis.journalToRemove) > 0
+}
+
+func (sis *subnetworkIndexStore) getSubnetworkData(subnetworkID *externalapi.DomainSubnetworkID) (*SubnetworkData, bool, error) {
+	if sis.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get subnetwork data while staging isn't empty")
+	}
+
+	return sis.getFromDatabase(subnetworkID)
+}
+
+func (sis *subnetworkIndexStore) getFromDatabase(subnetworkID *externalapi.DomainSubnetworkID) (*SubnetworkData, bool, error) {
+	serializedSubnetworkData, err := sis.database.Get(sis.convertSubnetworkIDToKey(subnetworkID))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	subnetworkData, err := deserializeSubnetworkData(serializedSubnetworkData)
+	if err != nil {
+		return nil, false, err
+	}
+	return subnetworkData, true, nil
+}
+
+func (sis *subnetworkIndexStore) getAllSubnetworkData() (SubnetworkDataMap, error) {
+	if sis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get subnetwork data while staging isn't empty")
+	}
+
+	cursor, err := sis.database.Cursor(subnetworkIndexBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	allSubnetworkData := make(SubnetworkDataMap)
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		subnetworkID, err := subnetworks.FromBytes(key.Suffix())
+		if err != nil {
+			return nil, err
+		}
+		serializedSubnetworkData, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		subnetworkData, err := deserializeSubnetworkData(serializedSubnetworkData)
+		if err != nil {
+			return nil, err
+		}
+		allSubnetworkData[*subnetworkID] = subnetworkData
+	}
+	return allSubnetworkData, nil
+}
+
+func (sis *subnetworkIndexStore) getVirtualSelectedParent() (*externalapi.DomainHash, error) {
+	if sis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual selected parent while staging isn't empty")
+	}
+
+	serializedHash, err := sis.database.Get(virtualSelectedParentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return externalapi.NewDomainHashFromByteSlice(serializedHash)
+}
+
+// getJournalEntry returns the committed journal entry of the given chain block, and whether
+// there is one. Chain blocks that didn't change the index don't have a journal entry
+func (sis *subnetworkIndexStore) getJournalEntry(chainBlockHash *externalapi.DomainHash) (
+	*chainBlockJournalEntry, bool, error) {
+
+	serializedJournalEntry, err := sis.database.Get(journalBucket.Key(chainBlockHash.ByteSlice()))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	journalEntry, err := deserializeChainBlockJournalEntry(serializedJournalEntry)
+	if err != nil {
+		return nil, false, err
+	}
+	return journalEntry, true, nil
+}
+
+// getJournalEntries returns all the committed journal entries, ordered by blue score
+func (sis *subnetworkIndexStore) getJournalEntries() ([]*chainBlockJournalEntry, error) {
+	cursor, err := sis.database.Cursor(journalBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var journalEntries []*chainBlockJournalEntry
+	for cursor.Next() {
+		serializedJournalEntry, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		journalEntry, err := deserializeChainBlockJournalEntry(serializedJournalEntry)
+		if err != nil {
+			return nil, err
+		}
+		journalEntries = append(journalEntries, journalEntry)
+	}
+
+	sort.Slice(journalEntries, func(i, j int) bool {
+		return journalEntries[i].blueScore < journalEntries[j].blueScore
+	})
+	return journalEntries, nil
+}
+
+// deleteVirtualSelectedParent marks the subnetwork index as "not synced", so
+// that if a reset doesn't go smoothly it will be called again next time
+func (sis *subnetworkIndexStore) deleteVirtualSelectedParent() error {
+	return sis.database.Delete(virtualSelectedParentKey)
+}
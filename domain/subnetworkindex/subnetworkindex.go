@@ -0,0 +1,340 @@
+package subnetworkindex
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/shatll-s/nexelliad/domain"
+	"github.com/shatll-s/nexelliad/domain/chainindex"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+// SubnetworkIndex maintains an index of the non-native subnetworks that
+// were registered or used by transactions accepted by the virtual selected
+// parent chain
+type SubnetworkIndex struct {
+	domain domain.Domain
+	store  *subnetworkIndexStore
+
+	// journalPruningPoint is the pruning point the journal was last pruned at
+	journalPruningPoint *externalapi.DomainHash
+
+	mutex sync.Mutex
+}
+
+// New creates a new subnetwork index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*SubnetworkIndex, error) {
+	subnetworkIndex := &SubnetworkIndex{
+		domain: domain,
+		store:  newSubnetworkIndexStore(database),
+	}
+	err := chainindex.Sync(domain.Consensus(), &chainindex.Index{
+		VirtualSelectedParent:       subnetworkIndex.store.getVirtualSelectedParent,
+		RemoveChainBlocks:           subnetworkIndex.removeChainBlocks,
+		AddChainBlocks:              subnetworkIndex.addChainBlocks,
+		UpdateVirtualSelectedParent: subnetworkIndex.store.updateVirtualSelectedParent,
+		Commit:                      subnetworkIndex.store.commit,
+		Discard:                     subnetworkIndex.store.discard,
+		Reset:                       subnetworkIndex.Reset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subnetworkIndex, nil
+}
+
+// Reset resyncs the subnetwork index from the acceptance data of the virtual
+// selected parent chain above the pruning point. Unlike the other indexes, the
+// changes of chain blocks at or below the pruning point are kept, since they
+// are final and their acceptance data might not be available anymore. Only the
+// changes of the chain blocks above the pruning point are reverted and redone.
+func (si *SubnetworkIndex) Reset() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "SubnetworkIndex.Reset")
+	defer onEnd()
+
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+
+	pruningPoint, err := si.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	err = si.revertNonFinalChainBlocks(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	virtualSelectedParent, err := chainindex.ForEachChunkAboveBlock(si.domain.Consensus(), pruningPoint,
+		func(chainBlocksChunk []*externalapi.DomainHash) error {
+			err := si.addChainBlocks(chainBlocksChunk)
+			if err != nil {
+				return err
+			}
+			return si.store.commit()
+		})
+	if err != nil {
+		return err
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	si.store.updateVirtualSelectedParent(virtualSelectedParent)
+	return si.store.commit()
+}
+
+// revertNonFinalChainBlocks reverts, from the newest to the oldest, the changes of all
+// the chain blocks in the journal that are above the given pruning point, and drops the
+// journal entries of the ones that are not
+func (si *SubnetworkIndex) revertNonFinalChainBlocks(pruningPoint *externalapi.DomainHash) error {
+	// First we mark the index as not synced, so if anything goes wrong, it will be reset again
+	err := si.store.deleteVirtualSelectedParent()
+	if err != nil {
+		return err
+	}
+
+	pruningPointHeader, err := si.domain.Consensus().GetBlockHeader(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	journalEntries, err := si.store.getJournalEntries()
+	if err != nil {
+		return err
+	}
+	for i := len(journalEntries) - 1; i >= 0; i-- {
+		if journalEntries[i].blueScore <= pruningPointHeader.BlueScore() {
+			si.store.removeJournalEntry(journalEntries[i].chainBlockHash)
+			continue
+		}
+		si.revertJournalEntry(journalEntries[i])
+	}
+
+	err = si.store.commit()
+	if err != nil {
+		return err
+	}
+	si.journalPruningPoint = pruningPoint
+	return nil
+}
+
+// Update updates the subnetwork index with the given DAG selected parent chain changes
+func (si *SubnetworkIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "SubnetworkIndex.Update")
+	defer onEnd()
+
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if chainChanges == nil || (len(chainChanges.Added) == 0 && len(chainChanges.Removed) == 0) {
+		return nil
+	}
+
+	log.Tracef("Updating subnetwork index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+	err := si.removeChainBlocks(chainChanges.Removed)
+	if err != nil {
+		si.store.discard()
+		return err
+	}
+
+	err = si.addChainBlocks(chainChanges.Added)
+	if err != nil {
+		si.store.discard()
+		return err
+	}
+
+	pruningPoint, err := si.pruneJournal()
+	if err != nil {
+		si.store.discard()
+		return err
+	}
+
+	if len(chainChanges.Added) > 0 {
+		si.store.updateVirtualSelectedParent(chainChanges.Added[len(chainChanges.Added)-1])
+	}
+
+	err = si.store.commit()
+	if err != nil {
+		return err
+	}
+	si.journalPruningPoint = pruningPoint
+	return nil
+}
+
+// pruneJournal drops the journal entries of the chain blocks that are no longer above
+// the pruning point, since their changes are final. Returns the pruning point the
+// journal was pruned at
+func (si *SubnetworkIndex) pruneJournal() (*externalapi.DomainHash, error) {
+	pruningPoint, err := si.domain.Consensus().PruningPoint()
+	if err != nil {
+		return nil, err
+	}
+	if pruningPoint.Equal(si.journalPruningPoint) {
+		return pruningPoint, nil
+	}
+
+	pruningPointHeader, err := si.domain.Consensus().GetBlockHeader(pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	journalEntries, err := si.store.getJournalEntries()
+	if err != nil {
+		return nil, err
+	}
+	for _, journalEntry := range journalEntries {
+		if journalEntry.blueScore > pruningPointHeader.BlueScore() {
+			break
+		}
+		si.store.removeJournalEntry(journalEntry.chainBlockHash)
+	}
+	return pruningPoint, nil
+}
+
+func (si *SubnetworkIndex) addChainBlocks(addedChainBlocks []*externalapi.DomainHash) error {
+	return chainindex.ForEachChainBlockAcceptanceData(si.domain.Consensus(), addedChainBlocks,
+		func(chainBlockHash *externalapi.DomainHash, chainBlockAcceptanceData externalapi.AcceptanceData) error {
+			chainBlockHeader, err := si.domain.Consensus().GetBlockHeader(chainBlockHash)
+			if err != nil {
+				return err
+			}
+
+			journalEntry := newChainBlockJournalEntry(chainBlockHash, chainBlockHeader.BlueScore())
+			for _, blockAcceptanceData := range chainBlockAcceptanceData {
+				for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+					if !transactionAcceptanceData.IsAccepted {
+						continue
+					}
+					err := si.addTransaction(transactionAcceptanceData.Transaction, blockAcceptanceData.BlockHash,
+						journalEntry)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// Most chain blocks don't touch any subnetwork, so only the ones that do are journaled
+			if len(journalEntry.previousSubnetworkData) > 0 {
+				si.store.addJournalEntry(journalEntry)
+			}
+			return nil
+		})
+}
+
+// removeChainBlocks reverts the changes of chain blocks that left the virtual selected parent
+// chain. Chain blocks are always removed from the tip of the chain backwards, so each one is
+// reverted on top of the data its journal entry was recorded against
+func (si *SubnetworkIndex) removeChainBlocks(removedChainBlocks []*externalapi.DomainHash) error {
+	for _, removedChainBlock := range removedChainBlocks {
+		journalEntry, found, err := si.store.getJournalEntry(removedChainBlock)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		si.revertJournalEntry(journalEntry)
+	}
+	return nil
+}
+
+// addTransaction stages the changes an accepted transaction makes to the subnetwork it
+// either uses or registers, and records the data the subnetwork had before the chain block
+// of journalEntry changed it. includingBlockHash is recorded as the first-seen block of
+// subnetworks that are not yet in the index
+func (si *SubnetworkIndex) addTransaction(transaction *externalapi.DomainTransaction,
+	includingBlockHash *externalapi.DomainHash, journalEntry *chainBlockJournalEntry) error {
+
+	subnetworkID, isRegistry := affectedSubnetworkID(transaction)
+	if subnetworkID == nil {
+		return nil
+	}
+
+	stagedSubnetworkData, found, err := si.store.getStaged(subnetworkID)
+	if err != nil {
+		return err
+	}
+
+	var subnetworkData SubnetworkData
+	if found {
+		subnetworkData = *stagedSubnetworkData
+	} else {
+		subnetworkData = SubnetworkData{FirstSeenBlockHash: includingBlockHash}
+	}
+	if _, ok := journalEntry.previousSubnetworkData[*subnetworkID]; !ok {
+		journalEntry.previousSubnetworkData[*subnetworkID] = stagedSubnetworkData
+	}
+
+	if isRegistry {
+		subnetworkData.IsRegistered = true
+		subnetworkData.GasLimit = binary.LittleEndian.Uint64(transaction.Payload)
+	} else {
+		subnetworkData.GasUsed += transaction.Gas
+		subnetworkData.TransactionCount++
+	}
+
+	si.store.update(subnetworkID, &subnetworkData)
+	return nil
+}
+
+// revertJournalEntry stages the data the subnetworks had before the chain block of the
+// given journal entry changed them, along with the removal of the journal entry
+func (si *SubnetworkIndex) revertJournalEntry(journalEntry *chainBlockJournalEntry) {
+	for subnetworkID, previousSubnetworkData := range journalEntry.previousSubnetworkData {
+		subnetworkID := subnetworkID
+		if previousSubnetworkData == nil {
+			si.store.remove(&subnetworkID)
+			continue
+		}
+		si.store.update(&subnetworkID, previousSubnetworkData)
+	}
+	si.store.removeJournalEntry(journalEntry.chainBlockHash)
+}
+
+// affectedSubnetworkID returns the subnetwork the given transaction affects, and whether
+// the transaction registers it. Returns nil for transactions in the native or built-in
+// subnetworks that don't register anything
+func affectedSubnetworkID(transaction *externalapi.DomainTransaction) (*externalapi.DomainSubnetworkID, bool) {
+	if transaction.SubnetworkID == subnetworks.SubnetworkIDRegistry {
+		// A registry transaction registers the subnetwork whose ID is the
+		// prefix of the transaction's ID
+		transactionID := consensushashing.TransactionID(transaction)
+		var subnetworkID externalapi.DomainSubnetworkID
+		copy(subnetworkID[:], transactionID.ByteSlice()[:externalapi.DomainSubnetworkIDSize])
+		return &subnetworkID, true
+	}
+	if subnetworks.IsBuiltInOrNative(transaction.SubnetworkID) {
+		return nil, false
+	}
+	return transaction.SubnetworkID.Clone(), false
+}
+
+// SubnetworkData returns the data of the given subnetwork, and
+// whether it was found in the index
+func (si *SubnetworkIndex) SubnetworkData(subnetworkID *externalapi.DomainSubnetworkID) (*SubnetworkData, bool, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "SubnetworkIndex.SubnetworkData")
+	defer onEnd()
+
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+
+	return si.store.getSubnetworkData(subnetworkID)
+}
+
+// AllSubnetworkData returns the data of all the subnetworks in the index
+func (si *SubnetworkIndex) AllSubnetworkData() (SubnetworkDataMap, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "SubnetworkIndex.AllSubnetworkData")
+	defer onEnd()
+
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+
+	return si.store.getAllSubnetworkData()
+}
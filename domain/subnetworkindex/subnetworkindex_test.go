@@ -0,0 +1,366 @@
+package subnetworkindex
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/blockheader"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/subnetworks"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+// fakeConsensus is a consensus with a single selected parent chain, that implements
+// only what the subnetwork index uses
+type fakeConsensus struct {
+	externalapi.Consensus
+
+	chain        []*externalapi.DomainHash
+	pruningPoint *externalapi.DomainHash
+	blueScores   map[externalapi.DomainHash]uint64
+
+	// acceptanceData is removed for chain blocks that were pruned
+	acceptanceData map[externalapi.DomainHash]externalapi.AcceptanceData
+}
+
+func newFakeConsensus() *fakeConsensus {
+	fc := &fakeConsensus{
+		blueScores:     make(map[externalapi.DomainHash]uint64),
+		acceptanceData: make(map[externalapi.DomainHash]externalapi.AcceptanceData),
+	}
+	fc.pruningPoint = fc.addChainBlock()
+	return fc
+}
+
+// addChainBlock adds a chain block on top of the chain that accepts the given transactions
+func (fc *fakeConsensus) addChainBlock(transactions ...*externalapi.DomainTransaction) *externalapi.DomainHash {
+	var blueScore uint64
+	if len(fc.chain) > 0 {
+		blueScore = fc.blueScores[*fc.chain[len(fc.chain)-1]] + 1
+	}
+	chainBlockHash := newHash(len(fc.blueScores) + 1)
+
+	transactionAcceptanceData := make([]*externalapi.TransactionAcceptanceData, len(transactions))
+	for i, transaction := range transactions {
+		transactionAcceptanceData[i] = &externalapi.TransactionAcceptanceData{Transaction: transaction, IsAccepted: true}
+	}
+
+	fc.chain = append(fc.chain, chainBlockHash)
+	fc.blueScores[*chainBlockHash] = blueScore
+	fc.acceptanceData[*chainBlockHash] = externalapi.AcceptanceData{{
+		BlockHash:                 chainBlockHash,
+		TransactionAcceptanceData: transactionAcceptanceData,
+	}}
+	return chainBlockHash
+}
+
+// removeChainBlocks removes the given amount of chain blocks from the tip of the chain,
+// and returns them starting from the tip
+func (fc *fakeConsensus) removeChainBlocks(amount int) []*externalapi.DomainHash {
+	removed := make([]*externalapi.DomainHash, amount)
+	for i := range removed {
+		removed[i] = fc.chain[len(fc.chain)-1-i]
+	}
+	fc.chain = fc.chain[:len(fc.chain)-amount]
+	return removed
+}
+
+// setPruningPoint moves the pruning point to the given chain block, and drops the
+// acceptance data of the chain blocks below it
+func (fc *fakeConsensus) setPruningPoint(pruningPoint *externalapi.DomainHash) {
+	for _, chainBlock := range fc.chain {
+		if chainBlock.Equal(pruningPoint) {
+			break
+		}
+		delete(fc.acceptanceData, *chainBlock)
+	}
+	fc.pruningPoint = pruningPoint
+}
+
+func (fc *fakeConsensus) PruningPoint() (*externalapi.DomainHash, error) {
+	return fc.pruningPoint, nil
+}
+
+func (fc *fakeConsensus) GetVirtualSelectedParent() (*externalapi.DomainHash, error) {
+	return fc.chain[len(fc.chain)-1], nil
+}
+
+func (fc *fakeConsensus) GetVirtualSelectedParentChainFromBlock(
+	blockHash *externalapi.DomainHash) (*externalapi.SelectedChainPath, error) {
+
+	for i, chainBlock := range fc.chain {
+		if chainBlock.Equal(blockHash) {
+			return &externalapi.SelectedChainPath{Added: append([]*externalapi.DomainHash{}, fc.chain[i+1:]...)}, nil
+		}
+	}
+	return nil, errors.Errorf("block %s is not in the chain", blockHash)
+}
+
+func (fc *fakeConsensus) GetBlocksAcceptanceData(
+	blockHashes []*externalapi.DomainHash) ([]externalapi.AcceptanceData, error) {
+
+	blocksAcceptanceData := make([]externalapi.AcceptanceData, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		acceptanceData, ok := fc.acceptanceData[*blockHash]
+		if !ok {
+			return nil, errors.Errorf("acceptance data of block %s is missing", blockHash)
+		}
+		blocksAcceptanceData[i] = acceptanceData
+	}
+	return blocksAcceptanceData, nil
+}
+
+func (fc *fakeConsensus) GetBlockHeader(blockHash *externalapi.DomainHash) (externalapi.BlockHeader, error) {
+	blueScore, ok := fc.blueScores[*blockHash]
+	if !ok {
+		return nil, errors.Errorf("block %s is missing", blockHash)
+	}
+	return blockheader.NewImmutableBlockHeader(0, nil, &externalapi.DomainHash{}, &externalapi.DomainHash{},
+		&externalapi.DomainHash{}, 0, 0, 0, blueScore, blueScore, big.NewInt(0), &externalapi.DomainHash{}), nil
+}
+
+type fakeDomain struct {
+	domain.Domain
+	consensus *fakeConsensus
+}
+
+func (fd *fakeDomain) Consensus() externalapi.Consensus {
+	return fd.consensus
+}
+
+func newHash(i int) *externalapi.DomainHash {
+	var hashBytes [externalapi.DomainHashSize]byte
+	binary.LittleEndian.PutUint64(hashBytes[:], uint64(i))
+	return externalapi.NewDomainHashFromByteArray(&hashBytes)
+}
+
+func registryTransaction(gasLimit uint64) (*externalapi.DomainTransaction, *externalapi.DomainSubnetworkID) {
+	payload := make([]byte, 8)
+	binary.LittleEndian.PutUint64(payload, gasLimit)
+	transaction := &externalapi.DomainTransaction{
+		SubnetworkID: subnetworks.SubnetworkIDRegistry,
+		Payload:      payload,
+	}
+
+	var subnetworkID externalapi.DomainSubnetworkID
+	copy(subnetworkID[:], consensushashing.TransactionID(transaction).ByteSlice())
+	return transaction, &subnetworkID
+}
+
+func subnetworkTransaction(subnetworkID *externalapi.DomainSubnetworkID, gas uint64) *externalapi.DomainTransaction {
+	return &externalapi.DomainTransaction{
+		SubnetworkID: *subnetworkID,
+		Gas:          gas,
+		Payload:      []byte{byte(gas)},
+	}
+}
+
+func newTestSubnetworkIndex(t *testing.T, consensus *fakeConsensus) *SubnetworkIndex {
+	database, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	t.Cleanup(func() { database.Close() })
+
+	subnetworkIndex, err := New(&fakeDomain{consensus: consensus}, database)
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+	return subnetworkIndex
+}
+
+func checkSubnetworkData(t *testing.T, subnetworkIndex *SubnetworkIndex, subnetworkID *externalapi.DomainSubnetworkID,
+	expected *SubnetworkData) {
+
+	t.Helper()
+	subnetworkData, found, err := subnetworkIndex.SubnetworkData(subnetworkID)
+	if err != nil {
+		t.Fatalf("SubnetworkData: %s", err)
+	}
+	if expected == nil {
+		if found {
+			t.Fatalf("Expected subnetwork %s to be missing but got %+v", subnetworkID, subnetworkData)
+		}
+		return
+	}
+	if !found {
+		t.Fatalf("Expected subnetwork %s to be found", subnetworkID)
+	}
+	if !subnetworkData.FirstSeenBlockHash.Equal(expected.FirstSeenBlockHash) ||
+		subnetworkData.IsRegistered != expected.IsRegistered || subnetworkData.GasLimit != expected.GasLimit ||
+		subnetworkData.GasUsed != expected.GasUsed || subnetworkData.TransactionCount != expected.TransactionCount {
+
+		t.Fatalf("Unexpected data of subnetwork %s: expected %+v, got %+v", subnetworkID, expected, subnetworkData)
+	}
+}
+
+func TestAddTransactionAndRevert(t *testing.T) {
+	subnetworkIndex := newTestSubnetworkIndex(t, newFakeConsensus())
+
+	registry, subnetworkID := registryTransaction(1000)
+	usage := subnetworkTransaction(subnetworkID, 7)
+	includingBlockHash := newHash(100)
+	otherIncludingBlockHash := newHash(101)
+
+	registeringJournalEntry := newChainBlockJournalEntry(includingBlockHash, 1)
+	err := subnetworkIndex.addTransaction(registry, includingBlockHash, registeringJournalEntry)
+	if err != nil {
+		t.Fatalf("addTransaction: %s", err)
+	}
+	// Transactions in the native subnetwork don't affect the index
+	err = subnetworkIndex.addTransaction(&externalapi.DomainTransaction{}, includingBlockHash, registeringJournalEntry)
+	if err != nil {
+		t.Fatalf("addTransaction: %s", err)
+	}
+	if previous, ok := registeringJournalEntry.previousSubnetworkData[*subnetworkID]; !ok || previous != nil ||
+		len(registeringJournalEntry.previousSubnetworkData) != 1 {
+
+		t.Fatalf("Expected the journal entry to record that the subnetwork is new, got %+v",
+			registeringJournalEntry.previousSubnetworkData)
+	}
+
+	usingJournalEntry := newChainBlockJournalEntry(otherIncludingBlockHash, 2)
+	for i := 0; i < 2; i++ {
+		err = subnetworkIndex.addTransaction(usage, otherIncludingBlockHash, usingJournalEntry)
+		if err != nil {
+			t.Fatalf("addTransaction: %s", err)
+		}
+	}
+	registered := &SubnetworkData{FirstSeenBlockHash: includingBlockHash, IsRegistered: true, GasLimit: 1000}
+	if previous := usingJournalEntry.previousSubnetworkData[*subnetworkID]; previous == nil || *previous != *registered {
+		t.Fatalf("Expected the journal entry to record %+v, got %+v", registered, previous)
+	}
+
+	err = subnetworkIndex.store.commit()
+	if err != nil {
+		t.Fatalf("commit: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, &SubnetworkData{
+		FirstSeenBlockHash: includingBlockHash, IsRegistered: true, GasLimit: 1000, GasUsed: 14, TransactionCount: 2})
+
+	subnetworkIndex.revertJournalEntry(usingJournalEntry)
+	err = subnetworkIndex.store.commit()
+	if err != nil {
+		t.Fatalf("commit: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, registered)
+
+	subnetworkIndex.revertJournalEntry(registeringJournalEntry)
+	err = subnetworkIndex.store.commit()
+	if err != nil {
+		t.Fatalf("commit: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, nil)
+}
+
+func TestUpdateWithReorg(t *testing.T) {
+	consensus := newFakeConsensus()
+	registry, subnetworkID := registryTransaction(1000)
+	registeringBlock := consensus.addChainBlock(registry)
+	consensus.addChainBlock(subnetworkTransaction(subnetworkID, 5))
+	subnetworkIndex := newTestSubnetworkIndex(t, consensus)
+
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, &SubnetworkData{
+		FirstSeenBlockHash: registeringBlock, IsRegistered: true, GasLimit: 1000, GasUsed: 5, TransactionCount: 1})
+
+	// Replace the last chain block with one that uses the subnetwork twice
+	removed := consensus.removeChainBlocks(1)
+	added := consensus.addChainBlock(subnetworkTransaction(subnetworkID, 3), subnetworkTransaction(subnetworkID, 4))
+	err := subnetworkIndex.Update(&externalapi.VirtualChangeSet{VirtualSelectedParentChainChanges: &externalapi.SelectedChainPath{
+		Added:   []*externalapi.DomainHash{added},
+		Removed: removed,
+	}})
+	if err != nil {
+		t.Fatalf("Update: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, &SubnetworkData{
+		FirstSeenBlockHash: registeringBlock, IsRegistered: true, GasLimit: 1000, GasUsed: 7, TransactionCount: 2})
+
+	// Replace the chain all the way down to the pruning point with one that never registers the subnetwork
+	removed = consensus.removeChainBlocks(2)
+	added = consensus.addChainBlock()
+	err = subnetworkIndex.Update(&externalapi.VirtualChangeSet{VirtualSelectedParentChainChanges: &externalapi.SelectedChainPath{
+		Added:   []*externalapi.DomainHash{added},
+		Removed: removed,
+	}})
+	if err != nil {
+		t.Fatalf("Update: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, nil)
+}
+
+func TestResetKeepsFinalChanges(t *testing.T) {
+	consensus := newFakeConsensus()
+	registry, subnetworkID := registryTransaction(1000)
+	registeringBlock := consensus.addChainBlock(registry)
+	usingBlock := consensus.addChainBlock(subnetworkTransaction(subnetworkID, 5))
+	consensus.addChainBlock(subnetworkTransaction(subnetworkID, 6))
+	subnetworkIndex := newTestSubnetworkIndex(t, consensus)
+
+	expected := &SubnetworkData{
+		FirstSeenBlockHash: registeringBlock, IsRegistered: true, GasLimit: 1000, GasUsed: 11, TransactionCount: 2}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, expected)
+
+	// Resetting must not count the chain above the pruning point twice
+	err := subnetworkIndex.Reset()
+	if err != nil {
+		t.Fatalf("Reset: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, expected)
+
+	// Move the pruning point past the registration, so that its acceptance data is gone
+	consensus.setPruningPoint(usingBlock)
+	added := consensus.addChainBlock()
+	err = subnetworkIndex.Update(&externalapi.VirtualChangeSet{VirtualSelectedParentChainChanges: &externalapi.SelectedChainPath{
+		Added: []*externalapi.DomainHash{added},
+	}})
+	if err != nil {
+		t.Fatalf("Update: %s", err)
+	}
+
+	journalEntries, err := subnetworkIndex.store.getJournalEntries()
+	if err != nil {
+		t.Fatalf("getJournalEntries: %s", err)
+	}
+	if len(journalEntries) != 1 {
+		t.Fatalf("Expected only the journal entry above the pruning point to be kept, got %d entries",
+			len(journalEntries))
+	}
+
+	err = subnetworkIndex.Reset()
+	if err != nil {
+		t.Fatalf("Reset: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, expected)
+}
+
+func TestNewCatchesUp(t *testing.T) {
+	database, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	consensus := newFakeConsensus()
+	registry, subnetworkID := registryTransaction(1000)
+	registeringBlock := consensus.addChainBlock(registry)
+	_, err = New(&fakeDomain{consensus: consensus}, database)
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+
+	// While the index is not running, the chain grows and the registration gets pruned
+	consensus.setPruningPoint(registeringBlock)
+	consensus.addChainBlock(subnetworkTransaction(subnetworkID, 5))
+
+	subnetworkIndex, err := New(&fakeDomain{consensus: consensus}, database)
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+	checkSubnetworkData(t, subnetworkIndex, subnetworkID, &SubnetworkData{
+		FirstSeenBlockHash: registeringBlock, IsRegistered: true, GasLimit: 1000, GasUsed: 5, TransactionCount: 1})
+}
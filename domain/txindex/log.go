@@ -0,0 +1,7 @@
+package txindex
+
+import (
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("TXIN")
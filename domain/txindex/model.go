@@ -0,0 +1,18 @@
+package txindex
+
+import (
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+)
+
+// TXAcceptanceData is the data the transaction index holds for every
+// transaction accepted by the virtual selected parent chain
+type TXAcceptanceData struct {
+	IncludingBlockHash *externalapi.DomainHash
+	AcceptingBlockHash *externalapi.DomainHash
+}
+
+// TXAcceptanceDataMap is a map between transaction IDs and their acceptance data
+type TXAcceptanceDataMap map[externalapi.DomainTransactionID]*TXAcceptanceData
+
+// TXIDs is a set of transaction IDs
+type TXIDs map[externalapi.DomainTransactionID]interface{}
@@ -0,0 +1,40 @@
+package txindex
+
+import (
+	"io"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const serializedTXAcceptanceDataSize = 2 * externalapi.DomainHashSize
+
+func serializeTXAcceptanceData(txAcceptanceData *TXAcceptanceData) []byte {
+	serializedTXAcceptanceData := make([]byte, serializedTXAcceptanceDataSize)
+	copy(serializedTXAcceptanceData[:externalapi.DomainHashSize], txAcceptanceData.IncludingBlockHash.ByteSlice())
+	copy(serializedTXAcceptanceData[externalapi.DomainHashSize:], txAcceptanceData.AcceptingBlockHash.ByteSlice())
+	return serializedTXAcceptanceData
+}
+
+func deserializeTXAcceptanceData(serializedTXAcceptanceData []byte) (*TXAcceptanceData, error) {
+	if len(serializedTXAcceptanceData) != serializedTXAcceptanceDataSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"transaction acceptance data", len(serializedTXAcceptanceData))
+	}
+
+	includingBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedTXAcceptanceData[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedTXAcceptanceData[externalapi.DomainHashSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TXAcceptanceData{
+		IncludingBlockHash: includingBlockHash,
+		AcceptingBlockHash: acceptingBlockHash,
+	}, nil
+}
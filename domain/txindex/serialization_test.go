@@ -0,0 +1,44 @@
+package txindex
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeTXAcceptanceData(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var includingBlockHashBytes, acceptingBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(includingBlockHashBytes[:])
+		r.Read(acceptingBlockHashBytes[:])
+		txAcceptanceData := &TXAcceptanceData{
+			IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&includingBlockHashBytes),
+			AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&acceptingBlockHashBytes),
+		}
+		result, err := deserializeTXAcceptanceData(serializeTXAcceptanceData(txAcceptanceData))
+		if err != nil {
+			t.Fatalf("Failed deserializing transaction acceptance data: %v", err)
+		}
+		if !result.IncludingBlockHash.Equal(txAcceptanceData.IncludingBlockHash) ||
+			!result.AcceptingBlockHash.Equal(txAcceptanceData.AcceptingBlockHash) {
+			t.Fatalf("Expected \n %+v \n==\n %+v\n", txAcceptanceData, result)
+		}
+	}
+}
+
+func Test_deserializeTXAcceptanceDataFailure(t *testing.T) {
+	txAcceptanceData := &TXAcceptanceData{
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	}
+	serialized := serializeTXAcceptanceData(txAcceptanceData)
+	_, err := deserializeTXAcceptanceData(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
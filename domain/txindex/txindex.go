@@ -0,0 +1,146 @@
+package txindex
+
+import (
+	"sync"
+
+	"github.com/shatll-s/nexelliad/domain"
+	"github.com/shatll-s/nexelliad/domain/chainindex"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database"
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+)
+
+// TXIndex maintains an index between transaction IDs and the blocks
+// that included and accepted them
+type TXIndex struct {
+	domain domain.Domain
+	store  *txIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new transaction index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*TXIndex, error) {
+	txIndex := &TXIndex{
+		domain: domain,
+		store:  newTXIndexStore(database),
+	}
+	err := chainindex.Sync(domain.Consensus(), &chainindex.Index{
+		VirtualSelectedParent:       txIndex.store.getVirtualSelectedParent,
+		RemoveChainBlocks:           txIndex.removeTXs,
+		AddChainBlocks:              txIndex.addTXs,
+		UpdateVirtualSelectedParent: txIndex.store.updateVirtualSelectedParent,
+		Commit:                      txIndex.store.commit,
+		Discard:                     txIndex.store.discard,
+		Reset:                       txIndex.Reset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txIndex, nil
+}
+
+// Reset deletes the whole transaction index and resyncs it from
+// the acceptance data of the virtual selected parent chain above
+// the pruning point.
+func (ti *TXIndex) Reset() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Reset")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	err := ti.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualSelectedParent, err := chainindex.ForEachChunkAbovePruningPoint(ti.domain.Consensus(),
+		func(chainBlocksChunk []*externalapi.DomainHash) error {
+			err := ti.addTXs(chainBlocksChunk)
+			if err != nil {
+				return err
+			}
+			return ti.store.commit()
+		})
+	if err != nil {
+		return err
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	ti.store.updateVirtualSelectedParent(virtualSelectedParent)
+	return ti.store.commit()
+}
+
+// Update updates the transaction index with the given DAG selected parent chain changes
+func (ti *TXIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Update")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if chainChanges == nil || (len(chainChanges.Added) == 0 && len(chainChanges.Removed) == 0) {
+		return nil
+	}
+
+	log.Tracef("Updating transaction index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+	err := ti.removeTXs(chainChanges.Removed)
+	if err != nil {
+		ti.store.discard()
+		return err
+	}
+
+	err = ti.addTXs(chainChanges.Added)
+	if err != nil {
+		ti.store.discard()
+		return err
+	}
+
+	if len(chainChanges.Added) > 0 {
+		ti.store.updateVirtualSelectedParent(chainChanges.Added[len(chainChanges.Added)-1])
+	}
+
+	return ti.store.commit()
+}
+
+func (ti *TXIndex) addTXs(addedChainBlocks []*externalapi.DomainHash) error {
+	return chainindex.ForEachAcceptedTransaction(ti.domain.Consensus(), addedChainBlocks,
+		func(acceptingBlockHash *externalapi.DomainHash, includingBlockHash *externalapi.DomainHash,
+			transactionAcceptanceData *externalapi.TransactionAcceptanceData) error {
+
+			txID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+			ti.store.add(txID, &TXAcceptanceData{
+				IncludingBlockHash: includingBlockHash,
+				AcceptingBlockHash: acceptingBlockHash,
+			})
+			return nil
+		})
+}
+
+func (ti *TXIndex) removeTXs(removedChainBlocks []*externalapi.DomainHash) error {
+	return chainindex.ForEachAcceptedTransaction(ti.domain.Consensus(), removedChainBlocks,
+		func(_ *externalapi.DomainHash, _ *externalapi.DomainHash,
+			transactionAcceptanceData *externalapi.TransactionAcceptanceData) error {
+
+			ti.store.remove(consensushashing.TransactionID(transactionAcceptanceData.Transaction))
+			return nil
+		})
+}
+
+// TXAcceptanceData returns the acceptance data of the given transaction, and
+// whether it was found in the index
+func (ti *TXIndex) TXAcceptanceData(txID *externalapi.DomainTransactionID) (*TXAcceptanceData, bool, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TXAcceptanceData")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	return ti.store.getTXAcceptanceData(txID)
+}
@@ -297,6 +297,93 @@ func (uis *utxoIndexStore) getUTXOOutpointEntryPairs(scriptPublicKey *externalap
 	return utxoOutpointEntryPairs, nil
 }
 
+// getUTXOOutpointEntryPairsPage returns, in database key order, the UTXOs of the given
+// scriptPublicKey that come after the given outpoint and whose amount is at least
+// minimumAmount. A nil after starts from the first UTXO, and a limit of 0 means no limit
+func (uis *utxoIndexStore) getUTXOOutpointEntryPairsPage(scriptPublicKey *externalapi.ScriptPublicKey,
+	after *externalapi.DomainOutpoint, limit int, minimumAmount uint64) ([]*externalapi.OutpointAndUTXOEntryPair, error) {
+
+	if uis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get utxo outpoint entry pairs while staging isn't empty")
+	}
+
+	bucket := uis.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := uis.database.Cursor(bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	hasCurrent, err := uis.seekPastOutpoint(cursor, bucket, after)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]*externalapi.OutpointAndUTXOEntryPair, 0)
+	for ; hasCurrent && (limit == 0 || len(pairs) < limit); hasCurrent = cursor.Next() {
+		serializedUTXOEntry, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		utxoEntry, err := deserializeUTXOEntry(serializedUTXOEntry)
+		if err != nil {
+			return nil, err
+		}
+		if utxoEntry.Amount() < minimumAmount {
+			continue
+		}
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		outpoint, err := uis.convertKeyToOutpoint(key)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &externalapi.OutpointAndUTXOEntryPair{
+			Outpoint:  outpoint,
+			UTXOEntry: utxoEntry,
+		})
+	}
+	return pairs, nil
+}
+
+// seekPastOutpoint moves the cursor to the first key that comes after the given
+// outpoint, or to the first key in the bucket if the outpoint is nil. The outpoint
+// itself doesn't have to exist, since it might have been spent in the meantime.
+// Returns whether the cursor points at a key
+func (uis *utxoIndexStore) seekPastOutpoint(cursor database.Cursor, bucket *database.Bucket,
+	outpoint *externalapi.DomainOutpoint) (bool, error) {
+
+	if outpoint == nil {
+		return cursor.First(), nil
+	}
+
+	outpointKey, err := uis.convertOutpointToKey(bucket, outpoint)
+	if err != nil {
+		return false, err
+	}
+	// Seek leaves the cursor at the first key that is greater than or equal to
+	// outpointKey, and only reports whether that key is outpointKey itself
+	err = cursor.Seek(outpointKey)
+	if err != nil && !database.IsNotFoundError(err) {
+		return false, err
+	}
+	isOutpointFound := err == nil
+
+	_, err = cursor.Key()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if isOutpointFound {
+		return cursor.Next(), nil
+	}
+	return true, nil
+}
+
 func (uis *utxoIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
 	if uis.isAnythingStaged() {
 		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
@@ -0,0 +1,126 @@
+package utxoindex
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/database/binaryserialization"
+	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/utxo"
+	"github.com/shatll-s/nexelliad/infrastructure/db/database/ldb"
+)
+
+func TestGetUTXOOutpointEntryPairsPage(t *testing.T) {
+	database, err := ldb.NewLevelDB(t.TempDir(), 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	err = database.Put(circulatingSupplyKey, binaryserialization.SerializeUint64(0))
+	if err != nil {
+		t.Fatalf("Put: %s", err)
+	}
+
+	store := newUTXOIndexStore(database)
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}}
+	otherScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3, 4}}
+
+	const utxoCount = 10
+	pairs := make([]*externalapi.OutpointAndUTXOEntryPair, 0, utxoCount+1)
+	for i := 0; i < utxoCount; i++ {
+		pairs = append(pairs, &externalapi.OutpointAndUTXOEntryPair{
+			Outpoint: &externalapi.DomainOutpoint{
+				TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{byte(i)}),
+				Index:         uint32(i),
+			},
+			UTXOEntry: utxo.NewUTXOEntry(uint64(i+1)*100, scriptPublicKey, false, 0),
+		})
+	}
+	// Make sure UTXOs of other scriptPublicKeys, even ones that share a prefix, don't leak into the result
+	pairs = append(pairs, &externalapi.OutpointAndUTXOEntryPair{
+		Outpoint:  &externalapi.DomainOutpoint{Index: 1000},
+		UTXOEntry: utxo.NewUTXOEntry(1, otherScriptPublicKey, false, 0),
+	})
+	err = store.addAndCommitOutpointsWithoutTransaction(pairs)
+	if err != nil {
+		t.Fatalf("addAndCommitOutpointsWithoutTransaction: %s", err)
+	}
+
+	all, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, nil, 0, 0)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %s", err)
+	}
+	if len(all) != utxoCount {
+		t.Fatalf("Expected %d UTXOs but got %d", utxoCount, len(all))
+	}
+
+	// Walking the pages must return every UTXO exactly once, in the same order as the unlimited query
+	var paged []*externalapi.OutpointAndUTXOEntryPair
+	var after *externalapi.DomainOutpoint
+	for {
+		page, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, after, 3, 0)
+		if err != nil {
+			t.Fatalf("getUTXOOutpointEntryPairsPage: %s", err)
+		}
+		if len(page) > 3 {
+			t.Fatalf("Expected at most 3 UTXOs but got %d", len(page))
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		after = page[len(page)-1].Outpoint
+	}
+	if len(paged) != len(all) {
+		t.Fatalf("Expected %d paged UTXOs but got %d", len(all), len(paged))
+	}
+	for i := range all {
+		if !paged[i].Outpoint.Equal(all[i].Outpoint) {
+			t.Fatalf("Unexpected outpoint at position %d: expected %s, got %s", i, all[i].Outpoint, paged[i].Outpoint)
+		}
+	}
+
+	// A cursor that no longer exists in the index must resume from the next outpoint in order
+	err = database.Delete(store.bucketForScriptPublicKey(scriptPublicKey).Key(mustSerializeOutpoint(t, all[4].Outpoint)))
+	if err != nil {
+		t.Fatalf("Delete: %s", err)
+	}
+	page, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, all[4].Outpoint, 1, 0)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %s", err)
+	}
+	if len(page) != 1 || !page[0].Outpoint.Equal(all[5].Outpoint) {
+		t.Fatalf("Expected the page after a removed cursor to start at %s, got %v", all[5].Outpoint, page)
+	}
+
+	// A cursor at the last outpoint returns an empty page
+	page, err = store.getUTXOOutpointEntryPairsPage(scriptPublicKey, all[len(all)-1].Outpoint, 3, 0)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %s", err)
+	}
+	if len(page) != 0 {
+		t.Fatalf("Expected an empty page after the last outpoint, got %d UTXOs", len(page))
+	}
+
+	const minimumAmount = 700
+	filtered, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, nil, 0, minimumAmount)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %s", err)
+	}
+	if len(filtered) != 4 {
+		t.Fatalf("Expected 4 UTXOs of at least %d sompi but got %d", minimumAmount, len(filtered))
+	}
+	for _, pair := range filtered {
+		if pair.UTXOEntry.Amount() < minimumAmount {
+			t.Fatalf("Got a UTXO of %d sompi, which is below the minimum of %d", pair.UTXOEntry.Amount(), minimumAmount)
+		}
+	}
+}
+
+func mustSerializeOutpoint(t *testing.T, outpoint *externalapi.DomainOutpoint) []byte {
+	serializedOutpoint, err := serializeOutpoint(outpoint)
+	if err != nil {
+		t.Fatalf("serializeOutpoint: %s", err)
+	}
+	return serializedOutpoint
+}
@@ -192,6 +192,23 @@ func (ui *UTXOIndex) UTXOs(scriptPublicKey *externalapi.ScriptPublicKey) (UTXOOu
 	return ui.store.getUTXOOutpointEntryPairs(scriptPublicKey)
 }
 
+// UTXOsPage returns up to limit UTXOs for the given scriptPublicKey, starting right
+// after the given outpoint and skipping UTXOs whose amount is below minimumAmount.
+// The UTXOs are returned in a stable order, so the outpoint of the last UTXO may
+// be passed as after to get the next page. A nil after starts from the first UTXO,
+// and a limit of 0 returns all the remaining UTXOs
+func (ui *UTXOIndex) UTXOsPage(scriptPublicKey *externalapi.ScriptPublicKey, after *externalapi.DomainOutpoint,
+	limit int, minimumAmount uint64) ([]*externalapi.OutpointAndUTXOEntryPair, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "UTXOIndex.UTXOsPage")
+	defer onEnd()
+
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	return ui.store.getUTXOOutpointEntryPairsPage(scriptPublicKey, after, limit, minimumAmount)
+}
+
 // GetCirculatingSompiSupply returns the current circulating supply of sompis in the network
 func (ui *UTXOIndex) GetCirculatingSompiSupply() (uint64, error) {
 
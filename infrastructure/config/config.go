@@ -48,6 +48,7 @@ const (
 	blockMaxMassMax              = 10_000_000
 	defaultMinRelayTxFee         = 1e-5 // 1 sompi per byte
 	defaultMaxOrphanTransactions = 100
+	defaultTxSelectionPolicy     = TxSelectionPolicyProbabilistic
 	//DefaultMaxOrphanTxSize is the default maximum size for an orphan transaction
 	DefaultMaxOrphanTxSize  = 100_000
 	defaultSigCacheMaxSize  = 100_000
@@ -56,6 +57,19 @@ const (
 	defaultProtocolVersion  = 5
 )
 
+// The policies by which transactions may be selected into block templates
+const (
+	// TxSelectionPolicyProbabilistic selects transactions at random, weighted by their fee rates
+	TxSelectionPolicyProbabilistic = "probabilistic"
+
+	// TxSelectionPolicyGreedy selects the transactions with the highest fee rates first
+	TxSelectionPolicyGreedy = "greedy"
+
+	// TxSelectionPolicyPriorityList selects the transactions pinned with the
+	// prioritizeTransaction RPC first, and then the ones with the highest fee rates
+	TxSelectionPolicyPriorityList = "prioritylist"
+)
+
 var (
 	// DefaultAppDir is the default home directory for nexelliad.
 	DefaultAppDir = util.AppDir("nexelliad", false)
@@ -94,7 +108,12 @@ type Flags struct {
 	RPCListeners                    []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 42110, testnet: 16210)"`
 	RPCCert                         string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                          string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCTLS                          bool          `long:"rpctls" description:"Serve RPC over TLS using --rpccert and --rpckey -- NOTE: A self-signed certificate and key are generated if neither file exists"`
+	RPCClientCA                     string        `long:"rpcclientca" description:"File containing the CA certificates used to verify RPC client certificates -- NOTE: When set, RPC clients must present a valid certificate"`
+	RPCAuthUsers                    []string      `long:"rpcauthuser" description:"Add RPC credentials in the form <role>:<user>:<password> -- Roles: admin (all commands), user (all but node control such as ShutDown, Ban and AddPeer), readonly (no submitting either) -- NOTE: Once any credentials are set, RPC clients must authenticate"`
+	RPCAuthTokens                   []string      `long:"rpcauthtoken" description:"Add an RPC bearer token in the form <role>:<token> -- See --rpcauthuser for the available roles"`
 	RPCMaxClients                   int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
+	RPCJSONListeners                []string      `long:"rpcjsonlisten" description:"Add an interface/port (eg. 127.0.0.1:42120) to listen for JSON encoded RPC requests over HTTP POST and websockets -- NOTE: This gateway is disabled unless a listener is given. It shares --rpctls and the RPC credentials with the gRPC server"`
 	RPCMaxWebsockets                int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
@@ -113,6 +132,7 @@ type Flags struct {
 	MinRelayTxFee                   float64       `long:"minrelaytxfee" description:"The minimum transaction fee in KAS/kB to be considered a non-zero fee."`
 	MaxOrphanTxs                    uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
 	BlockMaxMass                    uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
+	TxSelectionPolicy               string        `long:"txselectionpolicy" description:"The policy by which transactions are selected into block templates {probabilistic, greedy, prioritylist} -- prioritylist selects the transactions and addresses pinned with the prioritizeTransaction RPC first"`
 	UserAgentComments               []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters              bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
 	SigCacheMaxSize                 uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
@@ -122,6 +142,9 @@ type Flags struct {
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	TXIndex                         bool          `long:"txindex" description:"Enable the transaction index"`
+	SubnetworkIndex                 bool          `long:"subnetworkindex" description:"Enable the subnetwork index"`
+	HistoryIndex                    bool          `long:"historyindex" description:"Enable the address transaction history index"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
 	EnableSanityCheckPruningUTXOSet bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
@@ -188,6 +211,7 @@ func defaultFlags() *Flags {
 		RPCCert:              defaultRPCCertFile,
 		BlockMaxMass:         defaultBlockMaxMass,
 		MaxOrphanTxs:         defaultMaxOrphanTransactions,
+		TxSelectionPolicy:    defaultTxSelectionPolicy,
 		SigCacheMaxSize:      defaultSigCacheMaxSize,
 		MinRelayTxFee:        defaultMinRelayTxFee,
 		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
@@ -449,6 +473,21 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
+	cfg.RPCKey = cleanAndExpandPath(cfg.RPCKey)
+
+	// --rpcclientca is only meaningful with --rpctls.
+	if cfg.RPCClientCA != "" {
+		if !cfg.RPCTLS {
+			str := "%s: the --rpcclientca option requires --rpctls"
+			err := errors.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+		cfg.RPCClientCA = cleanAndExpandPath(cfg.RPCClientCA)
+	}
+
 	if cfg.RPCMaxConcurrentReqs < 0 {
 		str := "%s: The rpcmaxwebsocketconcurrentrequests option may " +
 			"not be less than 0 -- parsed [%d]"
@@ -490,6 +529,18 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	switch cfg.TxSelectionPolicy {
+	case TxSelectionPolicyProbabilistic, TxSelectionPolicyGreedy, TxSelectionPolicyPriorityList:
+	default:
+		str := "%s: The txselectionpolicy option must be one of %s, %s " +
+			"or %s -- parsed [%s]"
+		err := errors.Errorf(str, funcName, TxSelectionPolicyProbabilistic,
+			TxSelectionPolicyGreedy, TxSelectionPolicyPriorityList, cfg.TxSelectionPolicy)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Look for illegal characters in the user agent comments.
 	for _, uaComment := range cfg.UserAgentComments {
 		if strings.ContainsAny(uaComment, "/:()") {
@@ -518,6 +569,22 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// The JSON-RPC gateway has no default port, so every
+	// listener must specify one.
+	if cfg.DisableRPC {
+		cfg.RPCJSONListeners = nil
+	}
+	for _, listener := range cfg.RPCJSONListeners {
+		_, _, err := net.SplitHostPort(listener)
+		if err != nil {
+			str := "%s: invalid --rpcjsonlisten address %s: %s"
+			err := errors.Errorf(str, funcName, listener, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
 	// Disallow --addpeer and --connect used together
 	if len(cfg.AddPeers) > 0 && len(cfg.ConnectPeers) > 0 {
 		str := "%s: --addpeer and --connect can not be used together"
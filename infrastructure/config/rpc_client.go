@@ -0,0 +1,87 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/shatll-s/nexelliad/infrastructure/network/rpcclient/grpcclient"
+	"github.com/pkg/errors"
+)
+
+// RPCClientFlags holds the configuration for connecting to a nexelliad RPC server
+// over TLS and with credentials. It is meant to be embedded in the configuration
+// of RPC clients.
+type RPCClientFlags struct {
+	RPCTLS        bool   `long:"rpctls" description:"Connect to the RPC server over TLS"`
+	RPCCert       string `long:"rpccert" description:"File containing the RPC server's certificate or the CA that signed it (implies --rpctls). If omitted, the system's root CAs are used"`
+	RPCClientCert string `long:"rpcclientcert" description:"File containing the client certificate to present to the RPC server (implies --rpctls)"`
+	RPCClientKey  string `long:"rpcclientkey" description:"File containing the key for --rpcclientcert"`
+	RPCUser       string `long:"rpcuser" description:"RPC username"`
+	RPCPassword   string `long:"rpcpass" default-mask:"-" description:"RPC password"`
+	RPCToken      string `long:"rpctoken" default-mask:"-" description:"RPC bearer token (can't be used together with --rpcuser)"`
+}
+
+// RPCConnectOptions returns the options for connecting to the RPC server
+func (rpcClientFlags *RPCClientFlags) RPCConnectOptions() (*grpcclient.ConnectOptions, error) {
+	tlsConfig, err := rpcClientFlags.RPCTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var authorization string
+	switch {
+	case rpcClientFlags.RPCToken != "" && rpcClientFlags.RPCUser != "":
+		return nil, errors.New("--rpctoken and --rpcuser can't be used together")
+	case rpcClientFlags.RPCToken != "":
+		authorization = grpcclient.BearerAuthorization(rpcClientFlags.RPCToken)
+	case rpcClientFlags.RPCUser != "":
+		authorization = grpcclient.BasicAuthorization(rpcClientFlags.RPCUser, rpcClientFlags.RPCPassword)
+	case rpcClientFlags.RPCPassword != "":
+		return nil, errors.New("--rpcpass requires --rpcuser")
+	}
+
+	return &grpcclient.ConnectOptions{
+		TLSConfig:     tlsConfig,
+		Authorization: authorization,
+	}, nil
+}
+
+// RPCTLSConfig returns the TLS configuration for connecting to the RPC server,
+// or nil if TLS is not enabled.
+func (rpcClientFlags *RPCClientFlags) RPCTLSConfig() (*tls.Config, error) {
+	if !rpcClientFlags.RPCTLS && rpcClientFlags.RPCCert == "" && rpcClientFlags.RPCClientCert == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if rpcClientFlags.RPCCert != "" {
+		rpcCertPath := cleanAndExpandPath(rpcClientFlags.RPCCert)
+		pemCerts, err := os.ReadFile(rpcCertPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading the RPC certificate %s", rpcCertPath)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, errors.Errorf("no valid certificates found in %s", rpcCertPath)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (rpcClientFlags.RPCClientCert == "") != (rpcClientFlags.RPCClientKey == "") {
+		return nil, errors.New("--rpcclientcert and --rpcclientkey must be used together")
+	}
+	if rpcClientFlags.RPCClientCert != "" {
+		clientCertPath := cleanAndExpandPath(rpcClientFlags.RPCClientCert)
+		clientKeyPath := cleanAndExpandPath(rpcClientFlags.RPCClientKey)
+		clientCertificate, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error loading the RPC client certificate pair %s/%s",
+				clientCertPath, clientKeyPath)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+
+	return tlsConfig, nil
+}
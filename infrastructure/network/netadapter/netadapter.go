@@ -1,6 +1,7 @@
 package netadapter
 
 import (
+	"crypto/tls"
 	"sync"
 	"sync/atomic"
 
@@ -10,6 +11,7 @@ import (
 	routerpkg "github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/jsonrpcserver"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +30,7 @@ type NetAdapter struct {
 	p2pServer            server.P2PServer
 	p2pRouterInitializer RouterInitializer
 	rpcServer            server.Server
+	jsonRPCServer        server.Server
 	rpcRouterInitializer RouterInitializer
 	stop                 uint32
 
@@ -46,21 +49,41 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
-	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients)
+	var rpcTLSConfig *tls.Config
+	if cfg.RPCTLS {
+		rpcTLSConfig, err = grpcserver.RPCTLSConfig(cfg.RPCCert, cfg.RPCKey, cfg.RPCClientCA)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients, rpcTLSConfig)
 	if err != nil {
 		return nil, err
 	}
+	var jsonRPCServer server.Server
+	if len(cfg.RPCJSONListeners) > 0 {
+		jsonRPCServer, err = jsonrpcserver.NewJSONRPCServer(cfg.RPCJSONListeners, cfg.RPCMaxWebsockets,
+			cfg.RPCMaxConcurrentReqs, rpcTLSConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 	adapter := NetAdapter{
-		cfg:       cfg,
-		id:        netAdapterID,
-		p2pServer: p2pServer,
-		rpcServer: rpcServer,
+		cfg:           cfg,
+		id:            netAdapterID,
+		p2pServer:     p2pServer,
+		rpcServer:     rpcServer,
+		jsonRPCServer: jsonRPCServer,
 
 		p2pConnections: make(map[*NetConnection]struct{}),
 	}
 
 	adapter.p2pServer.SetOnConnectedHandler(adapter.onP2PConnectedHandler)
 	adapter.rpcServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	if adapter.jsonRPCServer != nil {
+		// JSON-RPC connections are served exactly like gRPC ones
+		adapter.jsonRPCServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	}
 
 	return &adapter, nil
 }
@@ -82,6 +105,12 @@ func (na *NetAdapter) Start() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -95,6 +124,12 @@ func (na *NetAdapter) Stop() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Stop()
+		if err != nil {
+			return err
+		}
+	}
 	return na.rpcServer.Stop()
 }
 
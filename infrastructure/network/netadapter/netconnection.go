@@ -76,6 +76,11 @@ func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
 }
 
+// Metadata returns the metadata the remote side sent when it initiated the connection
+func (c *NetConnection) Metadata() map[string][]string {
+	return c.connection.Metadata()
+}
+
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
 	return appmessage.NewNetAddress(c.connection.Address())
@@ -9,6 +9,7 @@ import (
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
@@ -21,6 +22,7 @@ type gRPCConnection struct {
 	stream                   grpcStream
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
+	metadata                 metadata.MD
 
 	// streamLock protects concurrent access to stream.
 	// Note that it's an RWMutex. Despite what the name
@@ -42,7 +44,7 @@ type grpcStream interface {
 }
 
 func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
-	lowLevelClientConnection *grpc.ClientConn) *gRPCConnection {
+	lowLevelClientConnection *grpc.ClientConn, metadata metadata.MD) *gRPCConnection {
 	connection := &gRPCConnection{
 		server:                   server,
 		address:                  address,
@@ -50,6 +52,7 @@ func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
 		stopChan:                 make(chan struct{}),
 		isConnected:              1,
 		lowLevelClientConnection: lowLevelClientConnection,
+		metadata:                 metadata,
 	}
 
 	return connection
@@ -100,6 +103,12 @@ func (c *gRPCConnection) IsOutbound() bool {
 	return c.lowLevelClientConnection != nil
 }
 
+// Metadata returns the metadata the remote side sent when it initiated
+// the connection. It is nil for outbound connections
+func (c *gRPCConnection) Metadata() map[string][]string {
+	return c.metadata
+}
+
 // Disconnect disconnects the connection
 // Calling this function a second time doesn't do anything
 //
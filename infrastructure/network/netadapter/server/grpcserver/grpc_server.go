@@ -11,6 +11,7 @@ import (
 	"github.com/shatll-s/nexelliad/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
 
@@ -26,10 +27,14 @@ type gRPCServer struct {
 }
 
 // newGRPCServer creates a gRPC server
-func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string) *gRPCServer {
+func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string,
+	extraServerOptions ...grpc.ServerOption) *gRPCServer {
+
 	log.Debugf("Created new %s GRPC server with maxMessageSize %d and maxInboundConnections %d", name, maxMessageSize, maxInboundConnections)
+	serverOptions := append([]grpc.ServerOption{grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)},
+		extraServerOptions...)
 	return &gRPCServer{
-		server:                     grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
+		server:                     grpc.NewServer(serverOptions...),
 		listeningAddresses:         listeningAddresses,
 		name:                       name,
 		maxInboundConnections:      maxInboundConnections,
@@ -110,7 +115,8 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 		return errors.Errorf("non-tcp connections are not supported")
 	}
 
-	connection := newConnection(s, tcpAddress, stream, nil)
+	incomingMetadata, _ := metadata.FromIncomingContext(ctx)
+	connection := newConnection(s, tcpAddress, stream, nil, incomingMetadata)
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
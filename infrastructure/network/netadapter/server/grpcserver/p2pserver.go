@@ -71,7 +71,7 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 		return nil, errors.Errorf("non-tcp addresses are not supported")
 	}
 
-	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection, nil)
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -0,0 +1,58 @@
+package protowire
+
+import (
+	"strings"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	payloadOneofName    = "payload"
+	rpcRequestSuffix    = "Request"
+	rpcResponseSuffix   = "Response"
+	rpcErrorFieldName   = "error"
+	rpcMessageFieldName = "message"
+)
+
+// RPCErrorResponse returns the response message that corresponds to the
+// given RPC request, with nothing but its error field set to rpcError.
+// It relies on every xRequest payload field having a matching xResponse
+// field with an `error` field of type RPCError.
+func RPCErrorResponse(request appmessage.Message, rpcError *appmessage.RPCError) (appmessage.Message, error) {
+	requestMessage, err := FromAppMessage(request)
+	if err != nil {
+		return nil, err
+	}
+	reflectRequest := requestMessage.ProtoReflect()
+	descriptor := reflectRequest.Descriptor()
+	requestField := reflectRequest.WhichOneof(descriptor.Oneofs().ByName(payloadOneofName))
+	if requestField == nil {
+		return nil, errors.Errorf("request %s has no payload", request.Command())
+	}
+	requestName := string(requestField.Name())
+	if !strings.HasSuffix(requestName, rpcRequestSuffix) {
+		return nil, errors.Errorf("%s is not an RPC request", requestName)
+	}
+	responseName := strings.TrimSuffix(requestName, rpcRequestSuffix) + rpcResponseSuffix
+	responseField := descriptor.Fields().ByName(protoreflect.Name(responseName))
+	if responseField == nil {
+		return nil, errors.Errorf("RPC request %s has no matching response", requestName)
+	}
+
+	responseMessage := &NexelliadMessage{}
+	reflectResponseMessage := responseMessage.ProtoReflect()
+	response := reflectResponseMessage.NewField(responseField).Message()
+	errorField := response.Descriptor().Fields().ByName(rpcErrorFieldName)
+	if errorField == nil {
+		return nil, errors.Errorf("RPC response %s has no error field", responseName)
+	}
+	errorMessage := response.NewField(errorField).Message()
+	errorMessage.Set(errorMessage.Descriptor().Fields().ByName(rpcMessageFieldName),
+		protoreflect.ValueOfString(rpcError.Message))
+	response.Set(errorField, protoreflect.ValueOfMessage(errorMessage))
+	reflectResponseMessage.Set(responseField, protoreflect.ValueOfMessage(response))
+
+	return responseMessage.ToAppMessage()
+}
@@ -0,0 +1,51 @@
+package protowire
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestRPCErrorResponse makes sure that every RPC request has a matching
+// response that RPCErrorResponse is able to build
+func TestRPCErrorResponse(t *testing.T) {
+	descriptor := (&NexelliadMessage{}).ProtoReflect().Descriptor()
+	fields := descriptor.Oneofs().ByName(payloadOneofName).Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !strings.HasSuffix(string(field.Name()), rpcRequestSuffix) {
+			continue
+		}
+
+		requestMessage := &NexelliadMessage{}
+		reflectRequestMessage := requestMessage.ProtoReflect()
+		reflectRequestMessage.Set(field, protoreflect.ValueOfMessage(reflectRequestMessage.NewField(field).Message()))
+		request, err := requestMessage.ToAppMessage()
+		if err != nil {
+			// Some requests can't be converted without their mandatory
+			// fields, so only make sure they have a matching response
+			responseName := strings.TrimSuffix(string(field.Name()), rpcRequestSuffix) + rpcResponseSuffix
+			if descriptor.Fields().ByName(protoreflect.Name(responseName)) == nil {
+				t.Fatalf("%s: no matching response", field.Name())
+			}
+			continue
+		}
+
+		rpcError := appmessage.RPCErrorf("test error for %s", field.Name())
+		response, err := RPCErrorResponse(request, rpcError)
+		if err != nil {
+			t.Fatalf("%s: RPCErrorResponse: %+v", field.Name(), err)
+		}
+		errorValue := reflect.ValueOf(response).Elem().FieldByName("Error")
+		if !errorValue.IsValid() {
+			t.Fatalf("%s: response %T has no Error field", field.Name(), response)
+		}
+		responseError, ok := errorValue.Interface().(*appmessage.RPCError)
+		if !ok || responseError == nil || responseError.Message != rpcError.Message {
+			t.Fatalf("%s: unexpected response error. Want: %s, got: %v", field.Name(), rpcError, errorValue.Interface())
+		}
+	}
+}
@@ -58,7 +58,7 @@ func (x *GetBalanceByAddressResponseMessage) toAppMessage() (appmessage.Message,
 		return nil, err
 	}
 
-	if rpcErr != nil && x.Balance != 1 {
+	if rpcErr != nil && x.Balance != 0 {
 		return nil, errors.New("GetBalanceByAddressResponse contains both an error and a response")
 	}
 
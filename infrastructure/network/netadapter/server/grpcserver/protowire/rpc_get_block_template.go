@@ -13,9 +13,20 @@ func (x *NexelliadMessage_GetBlockTemplateRequest) toAppMessage() (appmessage.Me
 }
 
 func (x *NexelliadMessage_GetBlockTemplateRequest) fromAppMessage(message *appmessage.GetBlockTemplateRequestMessage) error {
+	var payouts []*RpcCoinbasePayout
+	if len(message.Payouts) > 0 {
+		payouts = make([]*RpcCoinbasePayout, len(message.Payouts))
+		for i, payout := range message.Payouts {
+			payouts[i] = &RpcCoinbasePayout{}
+			payouts[i].fromAppMessage(payout)
+		}
+	}
 	x.GetBlockTemplateRequest = &GetBlockTemplateRequestMessage{
-		PayAddress: message.PayAddress,
-		ExtraData:  message.ExtraData,
+		PayAddress:                  message.PayAddress,
+		ExtraData:                   message.ExtraData,
+		LongPollTemplateId:          message.LongPollTemplateID,
+		LongPollTimeoutMilliseconds: message.LongPollTimeoutMilliseconds,
+		Payouts:                     payouts,
 	}
 	return nil
 }
@@ -24,12 +35,43 @@ func (x *GetBlockTemplateRequestMessage) toAppMessage() (appmessage.Message, err
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetBlockTemplateRequestMessage is nil")
 	}
+	var payouts []*appmessage.RPCCoinbasePayout
+	if len(x.Payouts) > 0 {
+		payouts = make([]*appmessage.RPCCoinbasePayout, len(x.Payouts))
+		for i, payout := range x.Payouts {
+			var err error
+			payouts[i], err = payout.toAppMessage()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	return &appmessage.GetBlockTemplateRequestMessage{
-		PayAddress: x.PayAddress,
-		ExtraData:  x.ExtraData,
+		PayAddress:                  x.PayAddress,
+		ExtraData:                   x.ExtraData,
+		LongPollTemplateID:          x.LongPollTemplateId,
+		LongPollTimeoutMilliseconds: x.LongPollTimeoutMilliseconds,
+		Payouts:                     payouts,
+	}, nil
+}
+
+func (x *RpcCoinbasePayout) toAppMessage() (*appmessage.RPCCoinbasePayout, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcCoinbasePayout is nil")
+	}
+	return &appmessage.RPCCoinbasePayout{
+		Address: x.Address,
+		Weight:  x.Weight,
 	}, nil
 }
 
+func (x *RpcCoinbasePayout) fromAppMessage(message *appmessage.RPCCoinbasePayout) {
+	*x = RpcCoinbasePayout{
+		Address: message.Address,
+		Weight:  message.Weight,
+	}
+}
+
 func (x *NexelliadMessage_GetBlockTemplateResponse) toAppMessage() (appmessage.Message, error) {
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetBlockTemplateResponse is nil")
@@ -54,9 +96,10 @@ func (x *NexelliadMessage_GetBlockTemplateResponse) fromAppMessage(message *appm
 	}
 
 	x.GetBlockTemplateResponse = &GetBlockTemplateResponseMessage{
-		Block:    block,
-		IsSynced: message.IsSynced,
-		Error:    err,
+		Block:      block,
+		IsSynced:   message.IsSynced,
+		TemplateId: message.TemplateID,
+		Error:      err,
 	}
 	return nil
 }
@@ -82,8 +125,9 @@ func (x *GetBlockTemplateResponseMessage) toAppMessage() (appmessage.Message, er
 		}
 	}
 	return &appmessage.GetBlockTemplateResponseMessage{
-		Block:    msgBlock,
-		IsSynced: x.IsSynced,
-		Error:    rpcError,
+		Block:      msgBlock,
+		IsSynced:   x.IsSynced,
+		TemplateID: x.TemplateId,
+		Error:      rpcError,
 	}, nil
 }
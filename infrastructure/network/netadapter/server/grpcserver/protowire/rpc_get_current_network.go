@@ -10,6 +10,7 @@ func (x *NexelliadMessage_GetCurrentNetworkRequest) toAppMessage() (appmessage.M
 }
 
 func (x *NexelliadMessage_GetCurrentNetworkRequest) fromAppMessage(_ *appmessage.GetCurrentNetworkRequestMessage) error {
+	x.GetCurrentNetworkRequest = &GetCurrentNetworkRequestMessage{}
 	return nil
 }
 
@@ -17,7 +18,7 @@ func (x *NexelliadMessage_GetCurrentNetworkResponse) toAppMessage() (appmessage.
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetCurrentNetworkResponse is nil")
 	}
-	return x.toAppMessage()
+	return x.GetCurrentNetworkResponse.toAppMessage()
 }
 
 func (x *NexelliadMessage_GetCurrentNetworkResponse) fromAppMessage(message *appmessage.GetCurrentNetworkResponseMessage) error {
@@ -0,0 +1,81 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_GetFeeEstimateRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetFeeEstimateRequest is nil")
+	}
+	return &appmessage.GetFeeEstimateRequestMessage{}, nil
+}
+
+func (x *NexelliadMessage_GetFeeEstimateRequest) fromAppMessage(_ *appmessage.GetFeeEstimateRequestMessage) error {
+	x.GetFeeEstimateRequest = &GetFeeEstimateRequestMessage{}
+	return nil
+}
+
+func (x *NexelliadMessage_GetFeeEstimateResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetFeeEstimateResponse is nil")
+	}
+	return x.GetFeeEstimateResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_GetFeeEstimateResponse) fromAppMessage(message *appmessage.GetFeeEstimateResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	x.GetFeeEstimateResponse = &GetFeeEstimateResponseMessage{
+		PriorityBucket: feeEstimateBucketFromAppMessage(message.PriorityBucket),
+		NormalBucket:   feeEstimateBucketFromAppMessage(message.NormalBucket),
+		LowBucket:      feeEstimateBucketFromAppMessage(message.LowBucket),
+		Error:          rpcErr,
+	}
+	return nil
+}
+
+func (x *GetFeeEstimateResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFeeEstimateResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && (x.PriorityBucket != nil || x.NormalBucket != nil || x.LowBucket != nil) {
+		return nil, errors.New("GetFeeEstimateResponseMessage contains both an error and a response")
+	}
+
+	return &appmessage.GetFeeEstimateResponseMessage{
+		PriorityBucket: x.PriorityBucket.toAppMessage(),
+		NormalBucket:   x.NormalBucket.toAppMessage(),
+		LowBucket:      x.LowBucket.toAppMessage(),
+		Error:          rpcErr,
+	}, nil
+}
+
+func (x *RpcFeeEstimateBucket) toAppMessage() *appmessage.RPCFeeEstimateBucket {
+	if x == nil {
+		return nil
+	}
+	return &appmessage.RPCFeeEstimateBucket{
+		FeeRate:          x.FeeRate,
+		EstimatedSeconds: x.EstimatedSeconds,
+	}
+}
+
+func feeEstimateBucketFromAppMessage(bucket *appmessage.RPCFeeEstimateBucket) *RpcFeeEstimateBucket {
+	if bucket == nil {
+		return nil
+	}
+	return &RpcFeeEstimateBucket{
+		FeeRate:          bucket.FeeRate,
+		EstimatedSeconds: bucket.EstimatedSeconds,
+	}
+}
@@ -41,8 +41,12 @@ func (x *NexelliadMessage_GetSubnetworkResponse) fromAppMessage(message *appmess
 		err = &RPCError{Message: message.Error.Message}
 	}
 	x.GetSubnetworkResponse = &GetSubnetworkResponseMessage{
-		GasLimit: message.GasLimit,
-		Error:    err,
+		GasLimit:           message.GasLimit,
+		FirstSeenBlockHash: message.FirstSeenBlockHash,
+		IsRegistered:       message.IsRegistered,
+		GasUsed:            message.GasUsed,
+		TransactionCount:   message.TransactionCount,
+		Error:              err,
 	}
 	return nil
 }
@@ -57,12 +61,16 @@ func (x *GetSubnetworkResponseMessage) toAppMessage() (appmessage.Message, error
 		return nil, err
 	}
 
-	if rpcErr != nil && x.GasLimit != 0 {
+	if rpcErr != nil && (x.GasLimit != 0 || x.FirstSeenBlockHash != "" || x.TransactionCount != 0) {
 		return nil, errors.New("GetSubnetworkResponseMessage contains both an error and a response")
 	}
 
 	return &appmessage.GetSubnetworkResponseMessage{
-		GasLimit: x.GasLimit,
-		Error:    rpcErr,
+		GasLimit:           x.GasLimit,
+		FirstSeenBlockHash: x.FirstSeenBlockHash,
+		IsRegistered:       x.IsRegistered,
+		GasUsed:            x.GasUsed,
+		TransactionCount:   x.TransactionCount,
+		Error:              rpcErr,
 	}, nil
 }
@@ -0,0 +1,90 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_GetSubnetworksRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetSubnetworksRequest is nil")
+	}
+	return &appmessage.GetSubnetworksRequestMessage{}, nil
+}
+
+func (x *NexelliadMessage_GetSubnetworksRequest) fromAppMessage(_ *appmessage.GetSubnetworksRequestMessage) error {
+	x.GetSubnetworksRequest = &GetSubnetworksRequestMessage{}
+	return nil
+}
+
+func (x *NexelliadMessage_GetSubnetworksResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetSubnetworksResponse is nil")
+	}
+	return x.GetSubnetworksResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_GetSubnetworksResponse) fromAppMessage(message *appmessage.GetSubnetworksResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	subnetworks := make([]*RpcSubnetwork, len(message.Subnetworks))
+	for i, subnetwork := range message.Subnetworks {
+		subnetworks[i] = &RpcSubnetwork{
+			SubnetworkId:       subnetwork.SubnetworkID,
+			FirstSeenBlockHash: subnetwork.FirstSeenBlockHash,
+			IsRegistered:       subnetwork.IsRegistered,
+			GasLimit:           subnetwork.GasLimit,
+			GasUsed:            subnetwork.GasUsed,
+			TransactionCount:   subnetwork.TransactionCount,
+		}
+	}
+	x.GetSubnetworksResponse = &GetSubnetworksResponseMessage{
+		Subnetworks: subnetworks,
+		Error:       rpcErr,
+	}
+	return nil
+}
+
+func (x *GetSubnetworksResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetSubnetworksResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Subnetworks) != 0 {
+		return nil, errors.New("GetSubnetworksResponseMessage contains both an error and a response")
+	}
+
+	subnetworks := make([]*appmessage.RPCSubnetwork, len(x.Subnetworks))
+	for i, subnetwork := range x.Subnetworks {
+		subnetworks[i], err = subnetwork.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetSubnetworksResponseMessage{
+		Subnetworks: subnetworks,
+		Error:       rpcErr,
+	}, nil
+}
+
+func (x *RpcSubnetwork) toAppMessage() (*appmessage.RPCSubnetwork, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcSubnetwork is nil")
+	}
+	return &appmessage.RPCSubnetwork{
+		SubnetworkID:       x.SubnetworkId,
+		FirstSeenBlockHash: x.FirstSeenBlockHash,
+		IsRegistered:       x.IsRegistered,
+		GasLimit:           x.GasLimit,
+		GasUsed:            x.GasUsed,
+		TransactionCount:   x.TransactionCount,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_GetTransactionsByAddressRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetTransactionsByAddressRequest is nil")
+	}
+	return x.GetTransactionsByAddressRequest.toAppMessage()
+}
+
+func (x *NexelliadMessage_GetTransactionsByAddressRequest) fromAppMessage(message *appmessage.GetTransactionsByAddressRequestMessage) error {
+	x.GetTransactionsByAddressRequest = &GetTransactionsByAddressRequestMessage{
+		Address: message.Address,
+		Limit:   message.Limit,
+		Cursor:  transactionsByAddressEntryFromAppMessage(message.Cursor),
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionsByAddressRequestMessage{
+		Address: x.Address,
+		Limit:   x.Limit,
+		Cursor:  x.Cursor.toAppMessage(),
+	}, nil
+}
+
+func (x *NexelliadMessage_GetTransactionsByAddressResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_GetTransactionsByAddressResponse is nil")
+	}
+	return x.GetTransactionsByAddressResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_GetTransactionsByAddressResponse) fromAppMessage(message *appmessage.GetTransactionsByAddressResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	entries := make([]*TransactionsByAddressEntry, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = transactionsByAddressEntryFromAppMessage(entry)
+	}
+	x.GetTransactionsByAddressResponse = &GetTransactionsByAddressResponseMessage{
+		Entries:    entries,
+		NextCursor: transactionsByAddressEntryFromAppMessage(message.NextCursor),
+		Error:      rpcErr,
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && (len(x.Entries) != 0 || x.NextCursor != nil) {
+		return nil, errors.New("GetTransactionsByAddressResponseMessage contains both an error and a response")
+	}
+
+	entries := make([]*appmessage.TransactionsByAddressEntry, len(x.Entries))
+	for i, entry := range x.Entries {
+		entries[i] = entry.toAppMessage()
+	}
+
+	return &appmessage.GetTransactionsByAddressResponseMessage{
+		Entries:    entries,
+		NextCursor: x.NextCursor.toAppMessage(),
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *TransactionsByAddressEntry) toAppMessage() *appmessage.TransactionsByAddressEntry {
+	if x == nil {
+		return nil
+	}
+	return &appmessage.TransactionsByAddressEntry{
+		TransactionID:     x.TransactionId,
+		AcceptingDAAScore: x.AcceptingDaaScore,
+		Direction:         appmessage.TransactionDirection(x.Direction),
+		Amount:            x.Amount,
+	}
+}
+
+func transactionsByAddressEntryFromAppMessage(entry *appmessage.TransactionsByAddressEntry) *TransactionsByAddressEntry {
+	if entry == nil {
+		return nil
+	}
+	return &TransactionsByAddressEntry{
+		TransactionId:     entry.TransactionID,
+		AcceptingDaaScore: entry.AcceptingDAAScore,
+		Direction:         TransactionsByAddressEntry_Direction(entry.Direction),
+		Amount:            entry.Amount,
+	}
+}
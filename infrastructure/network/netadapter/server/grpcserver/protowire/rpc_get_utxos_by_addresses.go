@@ -14,7 +14,10 @@ func (x *NexelliadMessage_GetUtxosByAddressesRequest) toAppMessage() (appmessage
 
 func (x *NexelliadMessage_GetUtxosByAddressesRequest) fromAppMessage(message *appmessage.GetUTXOsByAddressesRequestMessage) error {
 	x.GetUtxosByAddressesRequest = &GetUtxosByAddressesRequestMessage{
-		Addresses: message.Addresses,
+		Addresses:     message.Addresses,
+		Limit:         message.Limit,
+		MinimumAmount: message.MinimumAmount,
+		Cursor:        utxosByAddressesCursorFromAppMessage(message.Cursor),
 	}
 	return nil
 }
@@ -23,8 +26,16 @@ func (x *GetUtxosByAddressesRequestMessage) toAppMessage() (appmessage.Message,
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetUtxosByAddressesRequestMessage is nil")
 	}
+	cursor, err := x.Cursor.toAppMessage()
+	// Cursor is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
 	return &appmessage.GetUTXOsByAddressesRequestMessage{
-		Addresses: x.Addresses,
+		Addresses:     x.Addresses,
+		Limit:         x.Limit,
+		MinimumAmount: x.MinimumAmount,
+		Cursor:        cursor,
 	}, nil
 }
 
@@ -46,8 +57,9 @@ func (x *NexelliadMessage_GetUtxosByAddressesResponse) fromAppMessage(message *a
 		entries[i].fromAppMessage(entry)
 	}
 	x.GetUtxosByAddressesResponse = &GetUtxosByAddressesResponseMessage{
-		Entries: entries,
-		Error:   err,
+		Entries:    entries,
+		NextCursor: utxosByAddressesCursorFromAppMessage(message.NextCursor),
+		Error:      err,
 	}
 	return nil
 }
@@ -62,7 +74,7 @@ func (x *GetUtxosByAddressesResponseMessage) toAppMessage() (appmessage.Message,
 		return nil, err
 	}
 
-	if rpcErr != nil && len(x.Entries) != 0 {
+	if rpcErr != nil && (len(x.Entries) != 0 || x.NextCursor != nil) {
 		return nil, errors.New("GetUtxosByAddressesResponseMessage contains both an error and a response")
 	}
 
@@ -75,8 +87,49 @@ func (x *GetUtxosByAddressesResponseMessage) toAppMessage() (appmessage.Message,
 		entries[i] = entryAsAppMessage
 	}
 
+	nextCursor, err := x.NextCursor.toAppMessage()
+	// NextCursor is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
 	return &appmessage.GetUTXOsByAddressesResponseMessage{
-		Entries: entries,
-		Error:   rpcErr,
+		Entries:    entries,
+		NextCursor: nextCursor,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *UtxosByAddressesCursor) toAppMessage() (*appmessage.UTXOsByAddressesCursor, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "UtxosByAddressesCursor is nil")
+	}
+	// A nil outpoint is wrapped in a different error here so that callers that
+	// treat errorNil as a missing cursor don't silently drop a malformed one
+	if x.Outpoint == nil {
+		return nil, errors.New("UtxosByAddressesCursor has no outpoint")
+	}
+	outpoint, err := x.Outpoint.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.UTXOsByAddressesCursor{
+		Address:  x.Address,
+		Outpoint: outpoint,
 	}, nil
 }
+
+func utxosByAddressesCursorFromAppMessage(cursor *appmessage.UTXOsByAddressesCursor) *UtxosByAddressesCursor {
+	if cursor == nil {
+		return nil
+	}
+	var outpoint *RpcOutpoint
+	if cursor.Outpoint != nil {
+		outpoint = &RpcOutpoint{}
+		outpoint.fromAppMessage(cursor.Outpoint)
+	}
+	return &UtxosByAddressesCursor{
+		Address:  cursor.Address,
+		Outpoint: outpoint,
+	}
+}
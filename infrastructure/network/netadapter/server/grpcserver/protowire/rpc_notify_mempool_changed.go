@@ -0,0 +1,125 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_NotifyMempoolChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_NotifyMempoolChangedRequest is nil")
+	}
+	return x.NotifyMempoolChangedRequest.toAppMessage()
+}
+
+func (x *NexelliadMessage_NotifyMempoolChangedRequest) fromAppMessage(message *appmessage.NotifyMempoolChangedRequestMessage) error {
+	x.NotifyMempoolChangedRequest = &NotifyMempoolChangedRequestMessage{
+		Addresses: message.Addresses,
+	}
+	return nil
+}
+
+func (x *NotifyMempoolChangedRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyMempoolChangedRequestMessage is nil")
+	}
+	return &appmessage.NotifyMempoolChangedRequestMessage{
+		Addresses: x.Addresses,
+	}, nil
+}
+
+func (x *NexelliadMessage_NotifyMempoolChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_NotifyMempoolChangedResponse is nil")
+	}
+	return x.NotifyMempoolChangedResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_NotifyMempoolChangedResponse) fromAppMessage(message *appmessage.NotifyMempoolChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.NotifyMempoolChangedResponse = &NotifyMempoolChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *NotifyMempoolChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyMempoolChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifyMempoolChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *NexelliadMessage_MempoolChangedNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_MempoolChangedNotification is nil")
+	}
+	return x.MempoolChangedNotification.toAppMessage()
+}
+
+func (x *NexelliadMessage_MempoolChangedNotification) fromAppMessage(message *appmessage.MempoolChangedNotificationMessage) error {
+	added := make([]*MempoolEntry, len(message.Added))
+	for i, entry := range message.Added {
+		added[i] = &MempoolEntry{}
+		err := added[i].fromAppMessage(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	removed := make([]*RemovedMempoolEntry, len(message.Removed))
+	for i, entry := range message.Removed {
+		removed[i] = &RemovedMempoolEntry{
+			TransactionId: entry.TransactionID,
+			IsOrphan:      entry.IsOrphan,
+			Reason:        RemovedMempoolEntry_RemovalReason(entry.Reason),
+		}
+	}
+
+	x.MempoolChangedNotification = &MempoolChangedNotificationMessage{
+		Added:   added,
+		Removed: removed,
+	}
+	return nil
+}
+
+func (x *MempoolChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "MempoolChangedNotificationMessage is nil")
+	}
+	added := make([]*appmessage.MempoolEntry, len(x.Added))
+	for i, entry := range x.Added {
+		entryAsAppMessage, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		added[i] = entryAsAppMessage
+	}
+
+	removed := make([]*appmessage.RemovedMempoolEntry, len(x.Removed))
+	for i, entry := range x.Removed {
+		if entry == nil {
+			return nil, errors.Wrapf(errorNil, "RemovedMempoolEntry is nil")
+		}
+		removed[i] = &appmessage.RemovedMempoolEntry{
+			TransactionID: entry.TransactionId,
+			IsOrphan:      entry.IsOrphan,
+			Reason:        appmessage.MempoolRemovalReason(entry.Reason),
+		}
+	}
+
+	return &appmessage.MempoolChangedNotificationMessage{
+		Added:   added,
+		Removed: removed,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_PrioritizeTransactionRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_PrioritizeTransactionRequest is nil")
+	}
+	return x.PrioritizeTransactionRequest.toAppMessage()
+}
+
+func (x *NexelliadMessage_PrioritizeTransactionRequest) fromAppMessage(message *appmessage.PrioritizeTransactionRequestMessage) error {
+	x.PrioritizeTransactionRequest = &PrioritizeTransactionRequestMessage{
+		TransactionId: message.TransactionID,
+		Address:       message.Address,
+		FeeDelta:      message.FeeDelta,
+		Unpin:         message.Unpin,
+	}
+	return nil
+}
+
+func (x *PrioritizeTransactionRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "PrioritizeTransactionRequestMessage is nil")
+	}
+	return &appmessage.PrioritizeTransactionRequestMessage{
+		TransactionID: x.TransactionId,
+		Address:       x.Address,
+		FeeDelta:      x.FeeDelta,
+		Unpin:         x.Unpin,
+	}, nil
+}
+
+func (x *NexelliadMessage_PrioritizeTransactionResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_PrioritizeTransactionResponse is nil")
+	}
+	return x.PrioritizeTransactionResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_PrioritizeTransactionResponse) fromAppMessage(message *appmessage.PrioritizeTransactionResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.PrioritizeTransactionResponse = &PrioritizeTransactionResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *PrioritizeTransactionResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "PrioritizeTransactionResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.PrioritizeTransactionResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
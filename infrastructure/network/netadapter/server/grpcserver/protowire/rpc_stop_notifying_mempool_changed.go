@@ -0,0 +1,61 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_StopNotifyingMempoolChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_StopNotifyingMempoolChangedRequest is nil")
+	}
+	return x.StopNotifyingMempoolChangedRequest.toAppMessage()
+}
+
+func (x *NexelliadMessage_StopNotifyingMempoolChangedRequest) fromAppMessage(message *appmessage.StopNotifyingMempoolChangedRequestMessage) error {
+	x.StopNotifyingMempoolChangedRequest = &StopNotifyingMempoolChangedRequestMessage{
+		Addresses: message.Addresses,
+	}
+	return nil
+}
+
+func (x *StopNotifyingMempoolChangedRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StopNotifyingMempoolChangedRequestMessage is nil")
+	}
+	return &appmessage.StopNotifyingMempoolChangedRequestMessage{
+		Addresses: x.Addresses,
+	}, nil
+}
+
+func (x *NexelliadMessage_StopNotifyingMempoolChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_StopNotifyingMempoolChangedResponse is nil")
+	}
+	return x.StopNotifyingMempoolChangedResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_StopNotifyingMempoolChangedResponse) fromAppMessage(message *appmessage.StopNotifyingMempoolChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.StopNotifyingMempoolChangedResponse = &StopNotifyingMempoolChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *StopNotifyingMempoolChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StopNotifyingMempoolChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.StopNotifyingMempoolChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package protowire
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *NexelliadMessage_ValidateTransactionRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_ValidateTransactionRequest is nil")
+	}
+	return x.ValidateTransactionRequest.toAppMessage()
+}
+
+func (x *NexelliadMessage_ValidateTransactionRequest) fromAppMessage(message *appmessage.ValidateTransactionRequestMessage) error {
+	x.ValidateTransactionRequest = &ValidateTransactionRequestMessage{
+		Transaction: &RpcTransaction{},
+	}
+	x.ValidateTransactionRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *ValidateTransactionRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ValidateTransactionRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.ValidateTransactionRequestMessage{
+		Transaction: rpcTransaction,
+	}, nil
+}
+
+func (x *NexelliadMessage_ValidateTransactionResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NexelliadMessage_ValidateTransactionResponse is nil")
+	}
+	return x.ValidateTransactionResponse.toAppMessage()
+}
+
+func (x *NexelliadMessage_ValidateTransactionResponse) fromAppMessage(message *appmessage.ValidateTransactionResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	inputScriptResults := make([]*RpcInputScriptResult, len(message.InputScriptResults))
+	for i, inputScriptResult := range message.InputScriptResults {
+		inputScriptResults[i] = &RpcInputScriptResult{
+			IsValid: inputScriptResult.IsValid,
+			Error:   inputScriptResult.Error,
+		}
+	}
+	x.ValidateTransactionResponse = &ValidateTransactionResponseMessage{
+		IsAccepted:             message.IsAccepted,
+		RejectReason:           message.RejectReason,
+		Mass:                   message.Mass,
+		Fee:                    message.Fee,
+		FeeRate:                message.FeeRate,
+		StandardnessViolations: message.StandardnessViolations,
+		InputScriptResults:     inputScriptResults,
+		Error:                  err,
+	}
+	return nil
+}
+
+func (x *ValidateTransactionResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ValidateTransactionResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	inputScriptResults := make([]*appmessage.RPCInputScriptResult, len(x.InputScriptResults))
+	for i, inputScriptResult := range x.InputScriptResults {
+		appInputScriptResult, err := inputScriptResult.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		inputScriptResults[i] = appInputScriptResult
+	}
+	return &appmessage.ValidateTransactionResponseMessage{
+		IsAccepted:             x.IsAccepted,
+		RejectReason:           x.RejectReason,
+		Mass:                   x.Mass,
+		Fee:                    x.Fee,
+		FeeRate:                x.FeeRate,
+		StandardnessViolations: x.StandardnessViolations,
+		InputScriptResults:     inputScriptResults,
+		Error:                  rpcErr,
+	}, nil
+}
+
+func (x *RpcInputScriptResult) toAppMessage() (*appmessage.RPCInputScriptResult, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcInputScriptResult is nil")
+	}
+	return &appmessage.RPCInputScriptResult{
+		IsValid: x.IsValid,
+		Error:   x.Error,
+	}, nil
+}
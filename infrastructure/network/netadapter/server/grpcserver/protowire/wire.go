@@ -968,6 +968,125 @@ func toRPCPayload(message appmessage.Message) (isNexelliadMessage_Payload, error
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetTransactionRequestMessage:
+		payload := new(NexelliadMessage_GetTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionResponseMessage:
+		payload := new(NexelliadMessage_GetTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFeeEstimateRequestMessage:
+		payload := new(NexelliadMessage_GetFeeEstimateRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFeeEstimateResponseMessage:
+		payload := new(NexelliadMessage_GetFeeEstimateResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyMempoolChangedRequestMessage:
+		payload := new(NexelliadMessage_NotifyMempoolChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyMempoolChangedResponseMessage:
+		payload := new(NexelliadMessage_NotifyMempoolChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MempoolChangedNotificationMessage:
+		payload := new(NexelliadMessage_MempoolChangedNotification)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.StopNotifyingMempoolChangedRequestMessage:
+		payload := new(NexelliadMessage_StopNotifyingMempoolChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.StopNotifyingMempoolChangedResponseMessage:
+		payload := new(NexelliadMessage_StopNotifyingMempoolChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetSubnetworksRequestMessage:
+		payload := new(NexelliadMessage_GetSubnetworksRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetSubnetworksResponseMessage:
+		payload := new(NexelliadMessage_GetSubnetworksResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionsByAddressRequestMessage:
+		payload := new(NexelliadMessage_GetTransactionsByAddressRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionsByAddressResponseMessage:
+		payload := new(NexelliadMessage_GetTransactionsByAddressResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ValidateTransactionRequestMessage:
+		payload := new(NexelliadMessage_ValidateTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ValidateTransactionResponseMessage:
+		payload := new(NexelliadMessage_ValidateTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.PrioritizeTransactionRequestMessage:
+		payload := new(NexelliadMessage_PrioritizeTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.PrioritizeTransactionResponseMessage:
+		payload := new(NexelliadMessage_PrioritizeTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/shatll-s/nexelliad/util"
+	"github.com/pkg/errors"
+)
+
+// rpcCertValidity is the validity period of an auto-generated RPC certificate
+const rpcCertValidity = 10 * 365 * 24 * time.Hour
+
+// RPCTLSConfig returns a TLS configuration for the RPC server using the
+// certificate and key in the given files. If neither file exists, a new
+// self-signed certificate/key pair is generated and written to them.
+// If clientCAFile is not empty, clients are required to present a
+// certificate signed by one of the CAs it contains.
+func RPCTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	certExists, err := fileExists(certFile)
+	if err != nil {
+		return nil, err
+	}
+	keyExists, err := fileExists(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !certExists && !keyExists {
+		err := generateRPCCertPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading the RPC certificate pair %s/%s", certFile, keyFile)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		clientCAs, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// generateRPCCertPair generates a self-signed certificate/key pair and
+// writes them to the given files
+func generateRPCCertPair(certFile, keyFile string) error {
+	log.Infof("Generating TLS certificates...")
+
+	organization := "nexelliad autogenerated cert"
+	validUntil := time.Now().Add(rpcCertValidity)
+	cert, key, err := util.NewTLSCertPair(organization, validUntil, nil)
+	if err != nil {
+		return errors.Wrap(err, "error generating the RPC certificate pair")
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		err := os.MkdirAll(filepath.Dir(file), 0700)
+		if err != nil {
+			return errors.Wrapf(err, "error creating the directory for %s", file)
+		}
+	}
+
+	err = os.WriteFile(certFile, cert, 0666)
+	if err != nil {
+		return errors.Wrapf(err, "error writing the RPC certificate to %s", certFile)
+	}
+	err = os.WriteFile(keyFile, key, 0600)
+	if err != nil {
+		removeErr := os.Remove(certFile)
+		if removeErr != nil {
+			log.Warnf("Error removing %s: %s", certFile, removeErr)
+		}
+		return errors.Wrapf(err, "error writing the RPC key to %s", keyFile)
+	}
+
+	log.Infof("Done generating TLS certificates")
+	return nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pemCerts, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s", file)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemCerts) {
+		return nil, errors.Errorf("no valid certificates found in %s", file)
+	}
+	return certPool, nil
+}
+
+func fileExists(file string) (bool, error) {
+	_, err := os.Stat(file)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, errors.Wrapf(err, "error checking whether %s exists", file)
+}
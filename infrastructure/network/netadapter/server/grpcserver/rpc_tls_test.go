@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRPCTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "rpc.cert")
+	keyFile := filepath.Join(dir, "rpc.key")
+
+	// Neither file exists, so a new pair is expected to be generated
+	tlsConfig, err := RPCTLSConfig(certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("RPCTLSConfig: %+v", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("Unexpected amount of certificates. Want: 1, got: %d", len(tlsConfig.Certificates))
+	}
+	if tlsConfig.ClientAuth != tls.NoClientCert {
+		t.Fatalf("Unexpected ClientAuth without a client CA: %s", tlsConfig.ClientAuth)
+	}
+	generatedCert, err := os.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %+v", err)
+	}
+
+	// The existing pair is expected to be reused
+	tlsConfig, err = RPCTLSConfig(certFile, keyFile, certFile)
+	if err != nil {
+		t.Fatalf("RPCTLSConfig: %+v", err)
+	}
+	reloadedCert, err := os.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %+v", err)
+	}
+	if !bytes.Equal(generatedCert, reloadedCert) {
+		t.Fatalf("The RPC certificate was unexpectedly regenerated")
+	}
+	if tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+		t.Fatalf("Unexpected ClientAuth with a client CA: %s", tlsConfig.ClientAuth)
+	}
+	if tlsConfig.ClientCAs == nil {
+		t.Fatalf("ClientCAs unexpectedly nil")
+	}
+
+	// A missing key with an existing certificate is an error rather than
+	// a reason to overwrite the certificate
+	err = os.Remove(keyFile)
+	if err != nil {
+		t.Fatalf("Remove: %+v", err)
+	}
+	_, err = RPCTLSConfig(certFile, keyFile, "")
+	if err == nil {
+		t.Fatalf("RPCTLSConfig unexpectedly succeeded with a missing key")
+	}
+}
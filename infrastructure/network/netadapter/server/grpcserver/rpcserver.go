@@ -1,9 +1,13 @@
 package grpcserver
 
 import (
+	"crypto/tls"
+
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/shatll-s/nexelliad/util/panics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type rpcServer struct {
@@ -14,9 +18,14 @@ type rpcServer struct {
 // RPCMaxMessageSize is the max message size for the RPC server to send and receive
 const RPCMaxMessageSize = 1024 * 1024 * 1024 // 1 GB
 
-// NewRPCServer creates a new RPCServer
-func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int) (server.Server, error) {
-	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC")
+// NewRPCServer creates a new RPCServer. If tlsConfig is nil the server
+// accepts plaintext connections
+func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int, tlsConfig *tls.Config) (server.Server, error) {
+	var serverOptions []grpc.ServerOption
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC", serverOptions...)
 	rpcServer := &rpcServer{gRPCServer: *gRPCServer}
 	protowire.RegisterRPCServer(gRPCServer.server, rpcServer)
 	return rpcServer, nil
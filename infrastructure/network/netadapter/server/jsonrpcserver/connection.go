@@ -0,0 +1,195 @@
+package jsonrpcserver
+
+import (
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/pkg/errors"
+)
+
+// jsonStream is the JSON counterpart of the gRPC message stream.
+// Every message is a NexelliadMessage encoded with protojson
+type jsonStream interface {
+	Send(*protowire.NexelliadMessage) error
+	Recv() (*protowire.NexelliadMessage, error)
+	Close() error
+}
+
+type jsonRPCConnection struct {
+	address  *net.TCPAddr
+	stream   jsonStream
+	router   *router.Router
+	metadata map[string][]string
+
+	stopChan                chan struct{}
+	onDisconnectedHandler   server.OnDisconnectedHandler
+	onInvalidMessageHandler server.OnInvalidMessageHandler
+
+	isConnected uint32
+}
+
+func newConnection(address *net.TCPAddr, stream jsonStream, metadata map[string][]string) *jsonRPCConnection {
+	return &jsonRPCConnection{
+		address:     address,
+		stream:      stream,
+		metadata:    metadata,
+		stopChan:    make(chan struct{}),
+		isConnected: 1,
+	}
+}
+
+func (c *jsonRPCConnection) Start(router *router.Router) {
+	if c.onDisconnectedHandler == nil {
+		panic(errors.New("onDisconnectedHandler is nil"))
+	}
+
+	c.router = router
+
+	spawn("jsonRPCConnection.Start-connectionLoops", func() {
+		err := c.connectionLoops()
+		if err != nil {
+			log.Warnf("error from connectionLoops for %s: %s", c.address, err)
+		}
+	})
+}
+
+func (c *jsonRPCConnection) String() string {
+	return c.Address().String()
+}
+
+func (c *jsonRPCConnection) IsConnected() bool {
+	return atomic.LoadUint32(&c.isConnected) != 0
+}
+
+func (c *jsonRPCConnection) SetOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
+	c.onDisconnectedHandler = onDisconnectedHandler
+}
+
+func (c *jsonRPCConnection) SetOnInvalidMessageHandler(onInvalidMessageHandler server.OnInvalidMessageHandler) {
+	c.onInvalidMessageHandler = onInvalidMessageHandler
+}
+
+// IsOutbound always returns false, since the JSON-RPC server
+// only accepts inbound connections
+func (c *jsonRPCConnection) IsOutbound() bool {
+	return false
+}
+
+// Metadata returns the HTTP headers the client sent when it
+// initiated the connection, keyed by their lowercase names
+func (c *jsonRPCConnection) Metadata() map[string][]string {
+	return c.metadata
+}
+
+// Disconnect disconnects the connection
+// Calling this function a second time doesn't do anything
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Disconnect() {
+	if !c.IsConnected() {
+		return
+	}
+	atomic.StoreUint32(&c.isConnected, 0)
+
+	close(c.stopChan)
+
+	// ignore error because we don't really know what's the status of the connection
+	_ = c.stream.Close()
+
+	log.Debugf("Disconnecting from %s", c)
+	if c.onDisconnectedHandler != nil {
+		c.onDisconnectedHandler()
+	}
+}
+
+func (c *jsonRPCConnection) Address() *net.TCPAddr {
+	return c.address
+}
+
+func (c *jsonRPCConnection) connectionLoops() error {
+	errChan := make(chan error, 1) // buffered channel because one of the loops might try write after disconnect
+
+	spawn("jsonRPCConnection.receiveLoop", func() { errChan <- c.receiveLoop() })
+	spawn("jsonRPCConnection.sendLoop", func() { errChan <- c.sendLoop() })
+
+	err := <-errChan
+
+	c.Disconnect()
+
+	return err
+}
+
+func (c *jsonRPCConnection) sendLoop() error {
+	outgoingRoute := c.router.OutgoingRoute()
+	for c.IsConnected() {
+		message, err := outgoingRoute.Dequeue()
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			return err
+		}
+
+		log.Debugf("outgoing '%s' message to %s", message.Command(), c)
+		log.Tracef("outgoing '%s' message to %s: %s", message.Command(), c, logger.NewLogClosure(func() string {
+			return spew.Sdump(message)
+		}))
+
+		messageProto, err := protowire.FromAppMessage(message)
+		if err != nil {
+			return err
+		}
+
+		err = c.stream.Send(messageProto)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) receiveLoop() error {
+	messageNumber := uint64(0)
+	for c.IsConnected() {
+		protoMessage, err := c.stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return err
+		}
+		message, err := protoMessage.ToAppMessage()
+		if err != nil {
+			if c.onInvalidMessageHandler != nil {
+				c.onInvalidMessageHandler(err)
+			}
+			return err
+		}
+
+		messageNumber++
+		message.SetMessageNumber(messageNumber)
+		message.SetReceivedAt(time.Now())
+
+		log.Debugf("incoming '%s' message from %s (message number %d)", message.Command(), c,
+			message.MessageNumber())
+
+		err = c.router.EnqueueIncomingMessage(message)
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			if c.onInvalidMessageHandler != nil {
+				c.onInvalidMessageHandler(err)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package jsonrpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/shatll-s/nexelliad/util/panics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxMessageSize is the max size of a single JSON request or websocket frame
+const maxMessageSize = websocket.DefaultMaxPayloadBytes
+
+const readHeaderTimeout = 10 * time.Second
+
+type jsonRPCServer struct {
+	onConnectedHandler server.OnConnectedHandler
+	listeningAddresses []string
+	tlsConfig          *tls.Config
+	httpServers        []*http.Server
+	listeners          []net.Listener
+
+	websocketLimiter *connectionLimiter
+	requestLimiter   *connectionLimiter
+
+	websocketConnections     map[*jsonRPCConnection]struct{}
+	websocketConnectionsLock sync.Mutex
+}
+
+// NewJSONRPCServer creates a new server that accepts RPC messages
+// encoded as JSON, both as HTTP POST requests and over websockets.
+// If tlsConfig is nil the server accepts plaintext connections
+func NewJSONRPCServer(listeningAddresses []string, maxWebsockets int, maxConcurrentRequests int,
+	tlsConfig *tls.Config) (server.Server, error) {
+
+	return &jsonRPCServer{
+		listeningAddresses:   listeningAddresses,
+		tlsConfig:            tlsConfig,
+		websocketLimiter:     &connectionLimiter{name: "websocket connections", max: maxWebsockets},
+		requestLimiter:       &connectionLimiter{name: "concurrent HTTP requests", max: maxConcurrentRequests},
+		websocketConnections: make(map[*jsonRPCConnection]struct{}),
+	}, nil
+}
+
+func (s *jsonRPCServer) Start() error {
+	if s.onConnectedHandler == nil {
+		return errors.New("onConnectedHandler is nil")
+	}
+
+	for _, listenAddress := range s.listeningAddresses {
+		err := s.listenOn(listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonRPCServer) listenOn(listenAddr string) error {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return errors.Wrapf(err, "JSON-RPC error listening on %s", listenAddr)
+	}
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	httpServer := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	s.httpServers = append(s.httpServers, httpServer)
+	s.listeners = append(s.listeners, listener)
+
+	spawn("jsonRPCServer.listenOn-Serve", func() {
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panics.Exit(log, fmt.Sprintf("error serving JSON-RPC on %s: %+v", listenAddr, err))
+		}
+	})
+
+	log.Infof("JSON-RPC Server listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *jsonRPCServer) Stop() error {
+	const stopTimeout = 2 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	for _, httpServer := range s.httpServers {
+		err := httpServer.Shutdown(ctx)
+		if err != nil {
+			log.Warnf("Could not gracefully stop the JSON-RPC server: %s", err)
+			_ = httpServer.Close()
+		}
+	}
+
+	// Websocket connections are hijacked from the HTTP server,
+	// so Shutdown doesn't close them
+	s.websocketConnectionsLock.Lock()
+	defer s.websocketConnectionsLock.Unlock()
+	for connection := range s.websocketConnections {
+		connection.Disconnect()
+	}
+	return nil
+}
+
+// SetOnConnectedHandler sets the peer connected handler
+// function for the server
+func (s *jsonRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnectedHandler) {
+	s.onConnectedHandler = onConnectedHandler
+}
+
+func (s *jsonRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		s.handleWebsocket(w, r)
+		return
+	}
+	s.handlePost(w, r)
+}
+
+// handlePost handles a single request sent as the body of an HTTP POST,
+// and replies with the first response the RPC handlers produce
+func (s *jsonRPCServer) handlePost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST requests and websocket connections are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	// Requiring a JSON content type makes browsers send a CORS preflight
+	// request, which this server never approves, so a web page can't use
+	// a visitor's browser to call a node it can reach
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		http.Error(w, "the request must have content type application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+	address, err := tcpAddress(r.RemoteAddr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !s.requestLimiter.acquire() {
+		http.Error(w, "too many concurrent requests", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.requestLimiter.release()
+
+	requestBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading the request: %s", err), http.StatusBadRequest)
+		return
+	}
+	request := &protowire.NexelliadMessage{}
+	err = protojson.Unmarshal(requestBytes, request)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error parsing the request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	stream := newHTTPStream(request)
+	connection := newConnection(address, stream, requestMetadata(r))
+	invalidMessageErrChan := make(chan error, 1)
+	connection.SetOnInvalidMessageHandler(func(err error) {
+		invalidMessageErrChan <- err
+	})
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer connection.Disconnect()
+
+	select {
+	case response := <-stream.responseChan:
+		responseBytes, err := marshalOptions.Marshal(response)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error encoding the response: %s", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(responseBytes)
+	case err := <-invalidMessageErrChan:
+		http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+	case <-connection.stopChan:
+		http.Error(w, "the connection was closed before a response was sent", http.StatusServiceUnavailable)
+	case <-r.Context().Done():
+	}
+}
+
+// handleWebsocket upgrades the request to a websocket that stays
+// open until either side disconnects. Notifications the client
+// registers for are sent over it as they arrive
+func (s *jsonRPCServer) handleWebsocket(w http.ResponseWriter, r *http.Request) {
+	address, err := tcpAddress(r.RemoteAddr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !s.websocketLimiter.acquire() {
+		http.Error(w, "too many websocket connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.websocketLimiter.release()
+
+	websocketServer := websocket.Server{
+		Handshake: checkOrigin,
+		Handler: func(conn *websocket.Conn) {
+			conn.MaxPayloadBytes = maxMessageSize
+			connection := newConnection(address, &websocketStream{conn: conn}, requestMetadata(r))
+
+			s.addWebsocketConnection(connection)
+			defer s.removeWebsocketConnection(connection)
+
+			err := s.onConnectedHandler(connection)
+			if err != nil {
+				log.Warnf("Error handling JSON-RPC websocket connection from %s: %s", address, err)
+				return
+			}
+
+			log.Infof("JSON-RPC websocket connection from %s", address)
+			<-connection.stopChan
+		},
+	}
+	websocketServer.ServeHTTP(w, r)
+}
+
+func (s *jsonRPCServer) addWebsocketConnection(connection *jsonRPCConnection) {
+	s.websocketConnectionsLock.Lock()
+	defer s.websocketConnectionsLock.Unlock()
+
+	s.websocketConnections[connection] = struct{}{}
+}
+
+func (s *jsonRPCServer) removeWebsocketConnection(connection *jsonRPCConnection) {
+	s.websocketConnectionsLock.Lock()
+	defer s.websocketConnectionsLock.Unlock()
+
+	delete(s.websocketConnections, connection)
+}
+
+// checkOrigin rejects websocket handshakes that web pages on other
+// origins make. Non-browser clients don't send an Origin header at all
+func checkOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	if origin != nil && !strings.EqualFold(origin.Host, r.Host) {
+		return errors.Errorf("cross-origin websocket connections from %s are not allowed", origin)
+	}
+	config.Origin = origin
+	return nil
+}
+
+// requestMetadata returns the headers of the given request keyed
+// by their lowercase names, the same way gRPC metadata is keyed
+func requestMetadata(r *http.Request) map[string][]string {
+	metadata := make(map[string][]string, len(r.Header))
+	for key, values := range r.Header {
+		metadata[strings.ToLower(key)] = values
+	}
+	return metadata
+}
+
+func tcpAddress(remoteAddress string) (*net.TCPAddr, error) {
+	address, err := net.ResolveTCPAddr("tcp", remoteAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing remote address %s", remoteAddress)
+	}
+	return address, nil
+}
+
+// connectionLimiter limits the number of connections of a
+// certain kind that are open at the same time
+type connectionLimiter struct {
+	name  string
+	max   int
+	count int
+	lock  sync.Mutex
+}
+
+func (l *connectionLimiter) acquire() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.max > 0 && l.count >= l.max {
+		log.Warnf("Limit of %d JSON-RPC %s has been exceeded", l.max, l.name)
+		return false
+	}
+	l.count++
+	return true
+}
+
+func (l *connectionLimiter) release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.count--
+}
@@ -0,0 +1,180 @@
+package jsonrpcserver
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server"
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// startTestServer starts a JSON-RPC server that allows a single websocket
+// connection, and answers GetInfo and NotifyVirtualDaaScoreChanged requests.
+// GetInfo responses carry the request's authorization in their serverVersion
+func startTestServer(t *testing.T) string {
+	s, err := NewJSONRPCServer([]string{"127.0.0.1:0"}, 1, 0, nil)
+	if err != nil {
+		t.Fatalf("NewJSONRPCServer: %+v", err)
+	}
+	s.SetOnConnectedHandler(func(connection server.Connection) error {
+		connectionRouter := router.NewRouter("test")
+		incomingRoute, err := connectionRouter.AddIncomingRoute("test", []appmessage.MessageCommand{
+			appmessage.CmdGetInfoRequestMessage,
+			appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage,
+		})
+		if err != nil {
+			return err
+		}
+		connection.SetOnDisconnectedHandler(connectionRouter.Close)
+		connection.Start(connectionRouter)
+
+		go func() {
+			outgoingRoute := connectionRouter.OutgoingRoute()
+			for {
+				request, err := incomingRoute.Dequeue()
+				if err != nil {
+					return
+				}
+				switch request.(type) {
+				case *appmessage.GetInfoRequestMessage:
+					authorization := strings.Join(connection.Metadata()["authorization"], ",")
+					_ = outgoingRoute.Enqueue(appmessage.NewGetInfoResponseMessage("", 0, authorization, false, true))
+				case *appmessage.NotifyVirtualDaaScoreChangedRequestMessage:
+					_ = outgoingRoute.Enqueue(appmessage.NewNotifyVirtualDaaScoreChangedResponseMessage())
+					_ = outgoingRoute.Enqueue(appmessage.NewVirtualDaaScoreChangedNotificationMessage(42))
+				}
+			}
+		}()
+		return nil
+	})
+	err = s.Start()
+	if err != nil {
+		t.Fatalf("Start: %+v", err)
+	}
+	t.Cleanup(func() {
+		err := s.Stop()
+		if err != nil {
+			t.Errorf("Stop: %+v", err)
+		}
+	})
+	return s.(*jsonRPCServer).listeners[0].Addr().String()
+}
+
+func post(t *testing.T, address string, contentType string, body string) (int, string) {
+	request, err := http.NewRequest(http.MethodPost, "http://"+address, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %+v", err)
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Authorization", "Bearer test-token")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Do: %+v", err)
+	}
+	defer response.Body.Close()
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("ReadAll: %+v", err)
+	}
+	return response.StatusCode, string(responseBytes)
+}
+
+func TestPost(t *testing.T) {
+	address := startTestServer(t)
+
+	statusCode, responseJSON := post(t, address, "application/json", `{"getInfoRequest": {}}`)
+	if statusCode != http.StatusOK {
+		t.Fatalf("Unexpected status code %d: %s", statusCode, responseJSON)
+	}
+	response := &protowire.NexelliadMessage{}
+	err := protojson.Unmarshal([]byte(responseJSON), response)
+	if err != nil {
+		t.Fatalf("Unmarshal: %+v", err)
+	}
+	getInfoResponse := response.GetGetInfoResponse()
+	if getInfoResponse == nil {
+		t.Fatalf("Expected a getInfoResponse but got %s", responseJSON)
+	}
+	if getInfoResponse.ServerVersion != "Bearer test-token" {
+		t.Fatalf("The Authorization header was not passed as metadata. Got: %s", getInfoResponse.ServerVersion)
+	}
+
+	tests := []struct {
+		name               string
+		contentType        string
+		body               string
+		expectedStatusCode int
+	}{
+		{name: "malformed JSON", contentType: "application/json", body: `{"getInfoRequest"`, expectedStatusCode: http.StatusBadRequest},
+		{name: "unknown field", contentType: "application/json", body: `{"noSuchRequest": {}}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "unrouted message", contentType: "application/json", body: `{"getBlockCountRequest": {}}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "wrong content type", contentType: "text/plain", body: `{"getInfoRequest": {}}`, expectedStatusCode: http.StatusUnsupportedMediaType},
+	}
+	for _, test := range tests {
+		statusCode, responseBody := post(t, address, test.contentType, test.body)
+		if statusCode != test.expectedStatusCode {
+			t.Errorf("%s: unexpected status code. Want: %d, got: %d (%s)",
+				test.name, test.expectedStatusCode, statusCode, responseBody)
+		}
+	}
+
+	getResponse, err := http.Get("http://" + address)
+	if err != nil {
+		t.Fatalf("Get: %+v", err)
+	}
+	getResponse.Body.Close()
+	if getResponse.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("Unexpected status code for GET: %d", getResponse.StatusCode)
+	}
+}
+
+func TestWebsocket(t *testing.T) {
+	address := startTestServer(t)
+
+	_, err := websocket.Dial("ws://"+address, "", "http://evil.example")
+	if err == nil {
+		t.Fatalf("A cross-origin websocket connection was unexpectedly accepted")
+	}
+
+	conn, err := websocket.Dial("ws://"+address, "", "http://"+address)
+	if err != nil {
+		t.Fatalf("Dial: %+v", err)
+	}
+	defer conn.Close()
+
+	err = websocket.Message.Send(conn, `{"notifyVirtualDaaScoreChangedRequest": {}}`)
+	if err != nil {
+		t.Fatalf("Send: %+v", err)
+	}
+	receive := func() *protowire.NexelliadMessage {
+		var messageJSON string
+		err := websocket.Message.Receive(conn, &messageJSON)
+		if err != nil {
+			t.Fatalf("Receive: %+v", err)
+		}
+		message := &protowire.NexelliadMessage{}
+		err = protojson.Unmarshal([]byte(messageJSON), message)
+		if err != nil {
+			t.Fatalf("Unmarshal: %+v", err)
+		}
+		return message
+	}
+	if receive().GetNotifyVirtualDaaScoreChangedResponse() == nil {
+		t.Fatalf("Expected a notifyVirtualDaaScoreChangedResponse")
+	}
+	notification := receive().GetVirtualDaaScoreChangedNotification()
+	if notification == nil || notification.VirtualDaaScore != 42 {
+		t.Fatalf("Expected a virtualDaaScoreChangedNotification with score 42 but got %v", notification)
+	}
+
+	_, err = websocket.Dial("ws://"+address, "", "http://"+address)
+	if err == nil {
+		t.Fatalf("A websocket connection beyond the limit was unexpectedly accepted")
+	}
+}
@@ -0,0 +1,9 @@
+package jsonrpcserver
+
+import (
+	"github.com/shatll-s/nexelliad/infrastructure/logger"
+	"github.com/shatll-s/nexelliad/util/panics"
+)
+
+var log = logger.RegisterSubSystem("JRPC")
+var spawn = panics.GoroutineWrapperFunc(log)
@@ -0,0 +1,87 @@
+package jsonrpcserver
+
+import (
+	"io"
+	"sync"
+
+	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// websocketStream carries one JSON encoded NexelliadMessage
+// per websocket text frame, in both directions
+type websocketStream struct {
+	conn *websocket.Conn
+}
+
+func (s *websocketStream) Send(message *protowire.NexelliadMessage) error {
+	messageBytes, err := marshalOptions.Marshal(message)
+	if err != nil {
+		return errors.Wrapf(err, "error encoding the message")
+	}
+	return websocket.Message.Send(s.conn, string(messageBytes))
+}
+
+func (s *websocketStream) Recv() (*protowire.NexelliadMessage, error) {
+	var messageBytes []byte
+	err := websocket.Message.Receive(s.conn, &messageBytes)
+	if err != nil {
+		return nil, err
+	}
+	message := &protowire.NexelliadMessage{}
+	err = protojson.Unmarshal(messageBytes, message)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing the request")
+	}
+	return message, nil
+}
+
+func (s *websocketStream) Close() error {
+	return s.conn.Close()
+}
+
+// httpStream serves a single HTTP POST: it yields the posted request
+// once, and passes the first response on to responseChan
+type httpStream struct {
+	request      *protowire.NexelliadMessage
+	responseChan chan *protowire.NexelliadMessage
+	closeChan    chan struct{}
+	closeOnce    sync.Once
+}
+
+func newHTTPStream(request *protowire.NexelliadMessage) *httpStream {
+	return &httpStream{
+		request:      request,
+		responseChan: make(chan *protowire.NexelliadMessage, 1),
+		closeChan:    make(chan struct{}),
+	}
+}
+
+func (s *httpStream) Send(message *protowire.NexelliadMessage) error {
+	select {
+	case s.responseChan <- message:
+	default:
+		// An HTTP request gets a single response. Anything sent
+		// after it, such as notifications, is dropped
+	}
+	return nil
+}
+
+func (s *httpStream) Recv() (*protowire.NexelliadMessage, error) {
+	if s.request != nil {
+		request := s.request
+		s.request = nil
+		return request, nil
+	}
+	<-s.closeChan
+	return nil, io.EOF
+}
+
+func (s *httpStream) Close() error {
+	s.closeOnce.Do(func() { close(s.closeChan) })
+	return nil
+}
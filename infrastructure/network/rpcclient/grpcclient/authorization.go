@@ -0,0 +1,17 @@
+package grpcclient
+
+import "encoding/base64"
+
+// authorizationMetadataKey is the gRPC metadata key in which
+// credentials are passed to the RPC server
+const authorizationMetadataKey = "authorization"
+
+// BearerAuthorization returns the authorization for the given RPC token
+func BearerAuthorization(token string) string {
+	return "Bearer " + token
+}
+
+// BasicAuthorization returns the authorization for the given RPC user and password
+func BasicAuthorization(user string, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+}
@@ -2,6 +2,7 @@ package grpcclient
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"time"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/shatll-s/nexelliad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 )
 
 // OnErrorHandler defines a handler function for when errors occur
@@ -28,19 +31,45 @@ type GRPCClient struct {
 	onDisconnectedHandler OnDisconnectedHandler
 }
 
+// ConnectOptions defines optional settings for connecting to the RPC server
+type ConnectOptions struct {
+	// TLSConfig, if not nil, makes the connection over TLS
+	TLSConfig *tls.Config
+
+	// Authorization, if not empty, is passed to the server as the
+	// connection's credentials. See BearerAuthorization and BasicAuthorization
+	Authorization string
+}
+
 // Connect connects to the RPC server with the given address
 func Connect(address string) (*GRPCClient, error) {
+	return ConnectWithOptions(address, &ConnectOptions{})
+}
+
+// ConnectWithOptions connects to the RPC server with the given address
+// using the given options
+func ConnectWithOptions(address string, options *ConnectOptions) (*GRPCClient, error) {
 	const dialTimeout = 5 * time.Second
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	transportOption := grpc.WithInsecure()
+	if options.TLSConfig != nil {
+		transportOption = grpc.WithTransportCredentials(credentials.NewTLS(options.TLSConfig))
+	}
+
+	gRPCConnection, err := grpc.DialContext(ctx, address, transportOption, grpc.WithBlock())
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to %s", address)
 	}
 
+	streamContext := context.Background()
+	if options.Authorization != "" {
+		streamContext = metadata.AppendToOutgoingContext(streamContext, authorizationMetadataKey, options.Authorization)
+	}
+
 	grpcClient := protowire.NewRPCClient(gRPCConnection)
-	stream, err := grpcClient.MessageStream(context.Background(), grpc.UseCompressor(gzip.Name),
+	stream, err := grpcClient.MessageStream(streamContext, grpc.UseCompressor(gzip.Name),
 		grpc.MaxCallRecvMsgSize(grpcserver.RPCMaxMessageSize), grpc.MaxCallSendMsgSize(grpcserver.RPCMaxMessageSize))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting client stream for %s", address)
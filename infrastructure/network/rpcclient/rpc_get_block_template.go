@@ -1,14 +1,20 @@
 package rpcclient
 
-import "github.com/shatll-s/nexelliad/app/appmessage"
+import (
+	"time"
 
-// GetBlockTemplate sends an RPC request respective to the function's name and returns the RPC server's response
-func (c *RPCClient) GetBlockTemplate(miningAddress, extraData string) (*appmessage.GetBlockTemplateResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData))
+	"github.com/shatll-s/nexelliad/app/appmessage"
+)
+
+func (c *RPCClient) getBlockTemplate(request *appmessage.GetBlockTemplateRequestMessage) (
+	*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return nil, err
 	}
-	response, err := c.route(appmessage.CmdGetBlockTemplateResponseMessage).DequeueWithTimeout(c.timeout)
+	longPollTimeout := time.Duration(request.LongPollTimeoutMilliseconds) * time.Millisecond
+	response, err := c.route(appmessage.CmdGetBlockTemplateResponseMessage).DequeueWithTimeout(c.timeout + longPollTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -18,3 +24,28 @@ func (c *RPCClient) GetBlockTemplate(miningAddress, extraData string) (*appmessa
 	}
 	return getBlockTemplateResponse, nil
 }
+
+// GetBlockTemplate sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetBlockTemplate(miningAddress, extraData string) (*appmessage.GetBlockTemplateResponseMessage, error) {
+	return c.getBlockTemplate(appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData, "", 0))
+}
+
+// GetBlockTemplateLongPoll operates the same as GetBlockTemplate, except that the RPC server
+// holds back its response until the template differs from the one with the given ID,
+// or until the given timeout passes
+func (c *RPCClient) GetBlockTemplateLongPoll(miningAddress, extraData, templateID string,
+	timeout time.Duration) (*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	return c.getBlockTemplate(appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData,
+		templateID, uint64(timeout.Milliseconds())))
+}
+
+// GetBlockTemplateWithPayouts operates the same as GetBlockTemplate, except that the
+// block rewards are split between the given payouts instead of paid to a single address
+func (c *RPCClient) GetBlockTemplateWithPayouts(payouts []*appmessage.RPCCoinbasePayout,
+	extraData string) (*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	request := appmessage.NewGetBlockTemplateRequestMessage("", extraData, "", 0)
+	request.Payouts = payouts
+	return c.getBlockTemplate(request)
+}
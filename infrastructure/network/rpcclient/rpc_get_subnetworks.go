@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/shatll-s/nexelliad/app/appmessage"
+
+// GetSubnetworks sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetSubnetworks() (*appmessage.GetSubnetworksResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetSubnetworksRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetSubnetworksResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getSubnetworksResponse := response.(*appmessage.GetSubnetworksResponseMessage)
+	if getSubnetworksResponse.Error != nil {
+		return nil, c.convertRPCError(getSubnetworksResponse.Error)
+	}
+	return getSubnetworksResponse, nil
+}
@@ -4,7 +4,47 @@ import "github.com/shatll-s/nexelliad/app/appmessage"
 
 // GetUTXOsByAddresses sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetUTXOsByAddresses(addresses []string) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses))
+	return c.getUTXOsByAddresses(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses))
+}
+
+// GetUTXOsByAddressesPage sends a GetUTXOsByAddresses request for a single page of at most limit UTXOs,
+// starting after the given cursor. Pass a nil cursor to get the first page, and the response's
+// NextCursor to get the page that follows it
+func (c *RPCClient) GetUTXOsByAddressesPage(addresses []string, cursor *appmessage.UTXOsByAddressesCursor,
+	limit uint32, minimumAmount uint64) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
+
+	return c.getUTXOsByAddresses(
+		appmessage.NewGetUTXOsByAddressesPageRequestMessage(addresses, cursor, limit, minimumAmount))
+}
+
+// StreamUTXOsByAddresses fetches all the UTXOs of the given addresses page by page, calling onPage
+// with the entries of every page as it arrives. Iteration stops at the first error onPage returns
+func (c *RPCClient) StreamUTXOsByAddresses(addresses []string, pageSize uint32, minimumAmount uint64,
+	onPage func(entries []*appmessage.UTXOsByAddressesEntry) error) error {
+
+	var cursor *appmessage.UTXOsByAddressesCursor
+	for {
+		response, err := c.GetUTXOsByAddressesPage(addresses, cursor, pageSize, minimumAmount)
+		if err != nil {
+			return err
+		}
+		if len(response.Entries) > 0 {
+			err = onPage(response.Entries)
+			if err != nil {
+				return err
+			}
+		}
+		if response.NextCursor == nil {
+			return nil
+		}
+		cursor = response.NextCursor
+	}
+}
+
+func (c *RPCClient) getUTXOsByAddresses(
+	request *appmessage.GetUTXOsByAddressesRequestMessage) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return nil, err
 	}
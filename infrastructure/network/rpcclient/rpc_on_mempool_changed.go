@@ -0,0 +1,58 @@
+package rpcclient
+
+import (
+	"github.com/shatll-s/nexelliad/app/appmessage"
+	routerpkg "github.com/shatll-s/nexelliad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForMempoolChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
+// Additionally, it starts listening for the appropriate notification using the given handler function
+func (c *RPCClient) RegisterForMempoolChangedNotifications(addresses []string,
+	onMempoolChanged func(notification *appmessage.MempoolChangedNotificationMessage)) error {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyMempoolChangedRequestMessage(addresses))
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifyMempoolChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifyMempoolChangedResponse := response.(*appmessage.NotifyMempoolChangedResponseMessage)
+	if notifyMempoolChangedResponse.Error != nil {
+		return c.convertRPCError(notifyMempoolChangedResponse.Error)
+	}
+	spawn("RegisterForMempoolChangedNotifications", func() {
+		for {
+			notification, err := c.route(appmessage.CmdMempoolChangedNotificationMessage).Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			mempoolChangedNotification := notification.(*appmessage.MempoolChangedNotificationMessage)
+			onMempoolChanged(mempoolChangedNotification)
+		}
+	})
+	return nil
+}
+
+// UnregisterMempoolChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
+// Leave addresses empty to stop the notifications altogether
+func (c *RPCClient) UnregisterMempoolChangedNotifications(addresses []string) error {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewStopNotifyingMempoolChangedRequestMessage(addresses))
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdStopNotifyingMempoolChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	stopNotifyingMempoolChangedResponse := response.(*appmessage.StopNotifyingMempoolChangedResponseMessage)
+	if stopNotifyingMempoolChangedResponse.Error != nil {
+		return c.convertRPCError(stopNotifyingMempoolChangedResponse.Error)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package rpcclient
+
+import "github.com/shatll-s/nexelliad/app/appmessage"
+
+// PrioritizeTransaction sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) PrioritizeTransaction(transactionID, address string, feeDelta int64,
+	unpin bool) (*appmessage.PrioritizeTransactionResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewPrioritizeTransactionRequestMessage(transactionID, address, feeDelta, unpin))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdPrioritizeTransactionResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	prioritizeTransactionResponse := response.(*appmessage.PrioritizeTransactionResponseMessage)
+	if prioritizeTransactionResponse.Error != nil {
+		return nil, c.convertRPCError(prioritizeTransactionResponse.Error)
+	}
+	return prioritizeTransactionResponse, nil
+}
@@ -5,9 +5,11 @@ import (
 	"github.com/shatll-s/nexelliad/domain/consensus/model/externalapi"
 )
 
-func (c *RPCClient) submitBlock(block *externalapi.DomainBlock, allowNonDAABlocks bool) (appmessage.RejectReason, error) {
+func (c *RPCClient) submitBlock(block *externalapi.DomainBlock, allowNonDAABlocks bool,
+	templateID string) (appmessage.RejectReason, error) {
+
 	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewSubmitBlockRequestMessage(appmessage.DomainBlockToRPCBlock(block), allowNonDAABlocks))
+		appmessage.NewSubmitBlockRequestMessage(appmessage.DomainBlockToRPCBlock(block), allowNonDAABlocks, templateID))
 	if err != nil {
 		return appmessage.RejectReasonNone, err
 	}
@@ -24,10 +26,16 @@ func (c *RPCClient) submitBlock(block *externalapi.DomainBlock, allowNonDAABlock
 
 // SubmitBlock sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) SubmitBlock(block *externalapi.DomainBlock) (appmessage.RejectReason, error) {
-	return c.submitBlock(block, false)
+	return c.submitBlock(block, false, "")
 }
 
 // SubmitBlockAlsoIfNonDAA operates the same as SubmitBlock with the exception that `allowNonDAABlocks` is set to true
 func (c *RPCClient) SubmitBlockAlsoIfNonDAA(block *externalapi.DomainBlock) (appmessage.RejectReason, error) {
-	return c.submitBlock(block, true)
+	return c.submitBlock(block, true, "")
+}
+
+// SubmitBlockWithTemplateID operates the same as SubmitBlock, except that the block is rejected
+// with RejectReasonStaleTemplate if the template with the given ID is stale
+func (c *RPCClient) SubmitBlockWithTemplateID(block *externalapi.DomainBlock, templateID string) (appmessage.RejectReason, error) {
+	return c.submitBlock(block, false, templateID)
 }
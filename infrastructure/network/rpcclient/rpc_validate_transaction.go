@@ -0,0 +1,21 @@
+package rpcclient
+
+import "github.com/shatll-s/nexelliad/app/appmessage"
+
+// ValidateTransaction sends an RPC request respective to the function's name and returns the RPC server's response.
+// A transaction that would have been rejected is reported in the response rather than returned as an error
+func (c *RPCClient) ValidateTransaction(transaction *appmessage.RPCTransaction) (*appmessage.ValidateTransactionResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewValidateTransactionRequestMessage(transaction))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdValidateTransactionResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	validateTransactionResponse := response.(*appmessage.ValidateTransactionResponseMessage)
+	if validateTransactionResponse.Error != nil {
+		return nil, c.convertRPCError(validateTransactionResponse.Error)
+	}
+	return validateTransactionResponse, nil
+}
@@ -20,20 +20,32 @@ type RPCClient struct {
 	*grpcclient.GRPCClient
 
 	rpcAddress           string
+	connectOptions       *grpcclient.ConnectOptions
 	rpcRouter            *rpcRouter
 	isConnected          uint32
 	isClosed             uint32
 	isReconnecting       uint32
 	lastDisconnectedTime time.Time
 
+	// onReconnectedHandler is called after every successful reconnection. Notification
+	// registrations don't survive reconnections, so it's the place to renew them
+	onReconnectedHandler func()
+
 	timeout time.Duration
 }
 
 // NewRPCClient сreates a new RPC client with a default call timeout value
 func NewRPCClient(rpcAddress string) (*RPCClient, error) {
+	return NewRPCClientWithOptions(rpcAddress, &grpcclient.ConnectOptions{})
+}
+
+// NewRPCClientWithOptions creates a new RPC client with a default call timeout value,
+// that connects (and reconnects) using the given options
+func NewRPCClientWithOptions(rpcAddress string, connectOptions *grpcclient.ConnectOptions) (*RPCClient, error) {
 	rpcClient := &RPCClient{
-		rpcAddress: rpcAddress,
-		timeout:    defaultTimeout,
+		rpcAddress:     rpcAddress,
+		connectOptions: connectOptions,
+		timeout:        defaultTimeout,
 	}
 	err := rpcClient.connect()
 	if err != nil {
@@ -44,7 +56,7 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 }
 
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithOptions(c.rpcAddress, c.connectOptions)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
@@ -118,6 +130,9 @@ func (c *RPCClient) Reconnect() error {
 		if time.Since(c.lastDisconnectedTime) > retryDelay {
 			err := c.connect()
 			if err == nil {
+				if c.onReconnectedHandler != nil {
+					c.onReconnectedHandler()
+				}
 				return nil
 			}
 			log.Warnf("Could not automatically reconnect to %s: %s", c.rpcAddress, err)
@@ -150,6 +165,11 @@ func (c *RPCClient) handleClientError(err error) {
 	c.handleClientDisconnected()
 }
 
+// SetOnReconnectedHandler sets the handler that is called after every successful reconnection
+func (c *RPCClient) SetOnReconnectedHandler(onReconnectedHandler func()) {
+	c.onReconnectedHandler = onReconnectedHandler
+}
+
 // SetTimeout sets the timeout by which to wait for RPC responses
 func (c *RPCClient) SetTimeout(timeout time.Duration) {
 	c.timeout = timeout
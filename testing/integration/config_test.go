@@ -40,6 +40,7 @@ func setConfig(t *testing.T, harness *appHarness, protocolVersion uint32) {
 	harness.config.Listeners = []string{harness.p2pAddress}
 	harness.config.RPCListeners = []string{harness.rpcAddress}
 	harness.config.UTXOIndex = harness.utxoIndex
+	harness.config.TXIndex = harness.txIndex
 	harness.config.AllowSubmitBlockWhenNotSynced = true
 	if protocolVersion != 0 {
 		harness.config.ProtocolVersion = protocolVersion
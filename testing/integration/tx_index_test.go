@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/shatll-s/nexelliad/domain/consensus/utils/consensushashing"
+)
+
+func TestTXIndex(t *testing.T) {
+	// Setup a single nexelliad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		txIndex:                 true,
+	}
+	nexelliad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, nexelliad)
+
+	// Mine enough blocks for the first coinbase outputs to mature
+	const blockAmountToMine = 100
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, nexelliad)
+	}
+
+	utxosByAddressesResponse, err := nexelliad.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+	if len(utxosByAddressesResponse.Entries) == 0 {
+		t.Fatalf("No UTXOs found for %s", miningAddress1)
+	}
+	oldestEntry := utxosByAddressesResponse.Entries[0]
+	for _, entry := range utxosByAddressesResponse.Entries {
+		if entry.UTXOEntry.BlockDAAScore < oldestEntry.UTXOEntry.BlockDAAScore {
+			oldestEntry = entry
+		}
+	}
+
+	rpcTransaction := buildTransactionForUTXOIndexTest(t, oldestEntry)
+	submitTransactionResponse, err := nexelliad.rpcClient.SubmitTransaction(rpcTransaction, false)
+	if err != nil {
+		t.Fatalf("Error submitting transaction: %s", err)
+	}
+	transactionID := submitTransactionResponse.TransactionID
+
+	// Make sure the transaction is not reported before it's accepted
+	_, err = nexelliad.rpcClient.GetTransaction(transactionID)
+	if err == nil {
+		t.Fatalf("Expected an error for a transaction that is not yet accepted")
+	}
+
+	// Mine a block to include the transaction, and another one
+	// to accept it
+	includingBlock := mineNextBlock(t, nexelliad)
+	acceptingBlock := mineNextBlock(t, nexelliad)
+
+	getTransactionResponse, err := nexelliad.rpcClient.GetTransaction(transactionID)
+	if err != nil {
+		t.Fatalf("Error getting transaction: %s", err)
+	}
+	if getTransactionResponse.Transaction.VerboseData.TransactionID != transactionID {
+		t.Fatalf("Unexpected transaction ID. Want: %s, got: %s",
+			transactionID, getTransactionResponse.Transaction.VerboseData.TransactionID)
+	}
+	includingBlockHash := consensushashing.BlockHash(includingBlock).String()
+	if getTransactionResponse.IncludingBlockHash != includingBlockHash {
+		t.Fatalf("Unexpected including block hash. Want: %s, got: %s",
+			includingBlockHash, getTransactionResponse.IncludingBlockHash)
+	}
+	acceptingBlockHash := consensushashing.BlockHash(acceptingBlock).String()
+	if getTransactionResponse.AcceptingBlockHash != acceptingBlockHash {
+		t.Fatalf("Unexpected accepting block hash. Want: %s, got: %s",
+			acceptingBlockHash, getTransactionResponse.AcceptingBlockHash)
+	}
+	if getTransactionResponse.Confirmations != 1 {
+		t.Fatalf("Unexpected confirmations. Want: 1, got: %d", getTransactionResponse.Confirmations)
+	}
+
+	// Every further chain block adds a confirmation
+	mineNextBlock(t, nexelliad)
+	getTransactionResponse, err = nexelliad.rpcClient.GetTransaction(transactionID)
+	if err != nil {
+		t.Fatalf("Error getting transaction: %s", err)
+	}
+	if getTransactionResponse.Confirmations != 2 {
+		t.Fatalf("Unexpected confirmations. Want: 2, got: %d", getTransactionResponse.Confirmations)
+	}
+}
@@ -169,6 +169,32 @@ func TestUTXOIndex(t *testing.T) {
 				notificationEntry.UTXOEntry, foundResponseEntry.UTXOEntry)
 		}
 	}
+
+	// Stream the same UTXOs in small pages and make sure
+	// every one of them is returned exactly once
+	const pageSize = 7
+	streamedOutpoints := make(map[appmessage.RPCOutpoint]struct{})
+	err = nexelliad.rpcClient.StreamUTXOsByAddresses([]string{miningAddress1}, pageSize, 0,
+		func(entries []*appmessage.UTXOsByAddressesEntry) error {
+			if len(entries) > pageSize {
+				t.Fatalf("Got a page of %d UTXOs, which is more than the page size of %d", len(entries), pageSize)
+			}
+			for _, entry := range entries {
+				if _, ok := streamedOutpoints[*entry.Outpoint]; ok {
+					t.Fatalf("UTXO %s:%d was streamed more than once",
+						entry.Outpoint.TransactionID, entry.Outpoint.Index)
+				}
+				streamedOutpoints[*entry.Outpoint] = struct{}{}
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("Failed to stream UTXOs: %s", err)
+	}
+	if len(streamedOutpoints) != len(utxosByAddressesResponse.Entries) {
+		t.Fatalf("Unexpected amount of streamed UTXOs. Want: %d, got: %d",
+			len(utxosByAddressesResponse.Entries), len(streamedOutpoints))
+	}
 }
 
 func buildTransactionForUTXOIndexTest(t *testing.T, entry *appmessage.UTXOsByAddressesEntry) *appmessage.RPCTransaction {